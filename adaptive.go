@@ -0,0 +1,224 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Adaptive rate limiting.
+//
+// When the API quota is shared with other consumers, static token buckets can
+// still run into 429 responses. AdaptiveLimiter wraps a RateLimiter or
+// MultiRateLimiter and adjusts its limits from the responses HTTPClient feeds
+// back to it, AIMD-style: the limits are cut multiplicatively on a 429 and
+// recover additively while requests succeed. Rate-limit headers are not used:
+// a quota running low near the end of every window is normal under load and
+// does not call for slowing down.
+
+// ============================================================================
+// Response Feedback
+// ============================================================================
+
+// ResponseObserver is implemented by limiters that adapt to API responses.
+//
+// HTTPClient calls ObserveResponse on the endpoint's limiter after every
+// response, including the ones that are retried.
+type ResponseObserver interface {
+	ObserveResponse(statusCode int, header http.Header)
+}
+
+var _ ResponseObserver = (*AdaptiveLimiter)(nil)
+
+// ============================================================================
+// AdaptiveConfig
+// ============================================================================
+
+// AdaptiveConfig controls how an AdaptiveLimiter shrinks and recovers its limits.
+//
+// Zero-valued fields are replaced by the values of DefaultAdaptiveConfig.
+type AdaptiveConfig struct {
+	// DecreaseFactor multiplies the current limits on a 429 response.
+	// Optional, default: 0.5
+	DecreaseFactor float64
+
+	// IncreaseStep is the fraction of the original limits added back after
+	// each IncreaseInterval of successful responses.
+	// Optional, default: 0.1
+	IncreaseStep float64
+
+	// IncreaseInterval is the minimum time between two increases.
+	// Optional, default: 1s
+	IncreaseInterval time.Duration
+
+	// DecreaseCooldown is the minimum time between two decreases, so a burst
+	// of 429s from in-flight requests counts as a single signal.
+	// Optional, default: 1s
+	DecreaseCooldown time.Duration
+
+	// MinScale is the lowest fraction of the original limits the limiter
+	// shrinks to. Every limit stays at least 1.
+	// Optional, default: 0.05
+	MinScale float64
+}
+
+// DefaultAdaptiveConfig returns the adaptive configuration used for zero-valued fields.
+func DefaultAdaptiveConfig() AdaptiveConfig {
+	return AdaptiveConfig{
+		DecreaseFactor:   0.5,
+		IncreaseStep:     0.1,
+		IncreaseInterval: time.Second,
+		DecreaseCooldown: time.Second,
+		MinScale:         0.05,
+	}
+}
+
+// withDefaults returns a copy of the config with zero-valued fields filled in.
+func (c AdaptiveConfig) withDefaults() AdaptiveConfig {
+	def := DefaultAdaptiveConfig()
+	if c.DecreaseFactor <= 0 || c.DecreaseFactor >= 1 {
+		c.DecreaseFactor = def.DecreaseFactor
+	}
+	if c.IncreaseStep <= 0 {
+		c.IncreaseStep = def.IncreaseStep
+	}
+	if c.IncreaseInterval <= 0 {
+		c.IncreaseInterval = def.IncreaseInterval
+	}
+	if c.DecreaseCooldown <= 0 {
+		c.DecreaseCooldown = def.DecreaseCooldown
+	}
+	if c.MinScale <= 0 || c.MinScale > 1 {
+		c.MinScale = def.MinScale
+	}
+	return c
+}
+
+// ============================================================================
+// AdaptiveLimiter
+// ============================================================================
+
+// AdaptiveLimiter is a Limiter whose limits follow the responses of the API.
+//
+// It starts at the limits of the wrapped limiter and never exceeds them.
+type AdaptiveLimiter struct {
+	limiter  Limiter
+	setLimit func(index, limit int) error
+	base     []RateLimit
+	config   AdaptiveConfig
+	clock    Clock
+
+	mu           sync.Mutex
+	scale        float64
+	lastDecrease time.Time
+	lastIncrease time.Time
+}
+
+// NewAdaptiveLimiter wraps a limiter with AIMD adaptation.
+//
+// Cooldowns and increase intervals are measured on the clock of the wrapped limiter.
+//
+// Args:
+//   - limiter: A *RateLimiter, *SharedRateLimiter, *SlidingWindowLimiter, *MultiRateLimiter or *BackendLimiter
+//   - config: Adaptation parameters, zero values use DefaultAdaptiveConfig
+//
+// Example:
+//
+//	base, _ := birdeye.NewRateLimiter(100, time.Second, birdeye.RateLimitBlock)
+//	limiter, _ := birdeye.NewAdaptiveLimiter(base, birdeye.AdaptiveConfig{})
+//	client := birdeye.NewHTTPClient(birdeye.HTTPClientConfig{
+//	    APIKey:           "your-api-key",
+//	    EndpointLimiters: map[string]birdeye.Limiter{birdeye.EndpointDefiOHLCV: limiter},
+//	})
+func NewAdaptiveLimiter(limiter Limiter, config AdaptiveConfig) (*AdaptiveLimiter, error) {
+	al := &AdaptiveLimiter{
+		limiter: limiter,
+		config:  config.withDefaults(),
+		scale:   1,
+	}
+
+	switch l := limiter.(type) {
+	case *RateLimiter:
+		al.setLimit = func(_, limit int) error { return l.SetLimit(limit) }
+		al.clock = l.clock
+	case *SharedRateLimiter:
+		al.setLimit = func(_, limit int) error { return l.limiter.SetLimit(limit) }
+		al.clock = l.limiter.clock
+	case *SlidingWindowLimiter:
+		al.setLimit = func(_, limit int) error { return l.SetLimit(limit) }
+		al.clock = l.clock
+	case *MultiRateLimiter:
+		al.setLimit = l.SetLimit
+		al.clock = l.clock
+	case *BackendLimiter:
+		al.setLimit = l.SetLimit
+		al.clock = l.clock
+	default:
+		return nil, errors.New("adaptive limiting requires a RateLimiter, SharedRateLimiter, SlidingWindowLimiter, MultiRateLimiter or BackendLimiter")
+	}
+
+	for _, status := range limiter.GetStatus() {
+		al.base = append(al.base, RateLimit{Limit: status.Limit, Period: status.Period})
+	}
+	return al, nil
+}
+
+// Acquire attempts to acquire tokens from the wrapped limiter.
+func (al *AdaptiveLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error) {
+	return al.limiter.Acquire(ctx, tokens, onLimitExceeded)
+}
+
+// Wait blocks until a token is available or the context is cancelled.
+func (al *AdaptiveLimiter) Wait(ctx context.Context) error {
+	return al.limiter.Wait(ctx)
+}
+
+// GetStatus returns the status of the wrapped limiter, reflecting the current limits.
+func (al *AdaptiveLimiter) GetStatus() []LimiterStatus {
+	return al.limiter.GetStatus()
+}
+
+// Scale returns the current fraction (0-1] of the original limits.
+func (al *AdaptiveLimiter) Scale() float64 {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+	return al.scale
+}
+
+// ObserveResponse adapts the limits to a response of the API.
+//
+// A 429 decreases the limits. Any other response below 500 lets them recover
+// by IncreaseStep once per IncreaseInterval.
+func (al *AdaptiveLimiter) ObserveResponse(statusCode int, _ http.Header) {
+	al.mu.Lock()
+	defer al.mu.Unlock()
+
+	now := al.clock.Now()
+	if statusCode == http.StatusTooManyRequests {
+		if now.Sub(al.lastDecrease) < al.config.DecreaseCooldown {
+			return
+		}
+		al.lastDecrease = now
+		al.lastIncrease = now
+		al.apply(max(al.config.MinScale, al.scale*al.config.DecreaseFactor))
+		return
+	}
+
+	if statusCode >= 500 || al.scale >= 1 || now.Sub(al.lastIncrease) < al.config.IncreaseInterval {
+		return
+	}
+	al.lastIncrease = now
+	al.apply(min(1, al.scale+al.config.IncreaseStep))
+}
+
+// apply sets the limits of the wrapped limiter to the given fraction of the originals.
+func (al *AdaptiveLimiter) apply(scale float64) {
+	al.scale = scale
+	for i, rate := range al.base {
+		limit := max(1, int(math.Round(float64(rate.Limit)*scale)))
+		al.setLimit(i, limit)
+	}
+}
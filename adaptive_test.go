@@ -0,0 +1,116 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAdaptiveLimiterAIMD(t *testing.T) {
+	base, _ := NewRateLimiter(100, time.Second, RateLimitBlock)
+	limiter, err := NewAdaptiveLimiter(base, AdaptiveConfig{
+		IncreaseInterval: time.Millisecond,
+		DecreaseCooldown: time.Millisecond,
+		IncreaseStep:     0.25,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limiter.ObserveResponse(http.StatusTooManyRequests, nil)
+	if base.Limit() != 50 {
+		t.Fatalf("Expected limit 50 after 429, got %d", base.Limit())
+	}
+
+	// Decreases within the cooldown count as one signal
+	limiter.config.DecreaseCooldown = time.Hour
+	limiter.ObserveResponse(http.StatusTooManyRequests, nil)
+	if base.Limit() != 50 {
+		t.Fatalf("Expected limit 50 within cooldown, got %d", base.Limit())
+	}
+
+	time.Sleep(2 * time.Millisecond)
+	limiter.ObserveResponse(http.StatusOK, nil)
+	if base.Limit() != 75 {
+		t.Fatalf("Expected limit 75 after recovery step, got %d", base.Limit())
+	}
+
+	for range 5 {
+		time.Sleep(2 * time.Millisecond)
+		limiter.ObserveResponse(http.StatusOK, nil)
+	}
+	if base.Limit() != 100 || limiter.Scale() != 1 {
+		t.Fatalf("Expected full recovery, got limit %d scale %f", base.Limit(), limiter.Scale())
+	}
+}
+
+func TestAdaptiveLimiterMultiTier(t *testing.T) {
+	base, _ := NewMultiRateLimiter([]RateLimit{
+		{Limit: 30, Period: time.Second},
+		{Limit: 150, Period: time.Minute},
+	}, RateLimitBlock)
+	limiter, err := NewAdaptiveLimiter(base, AdaptiveConfig{MinScale: 0.1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 10 {
+		limiter.ObserveResponse(http.StatusTooManyRequests, nil)
+		limiter.lastDecrease = time.Time{}
+	}
+	status := limiter.GetStatus()
+	if status[0].Limit != 3 || status[1].Limit != 15 {
+		t.Fatalf("Expected limits floored at 10%%, got %+v", status)
+	}
+}
+
+func TestAdaptiveLimiterRateLimitHeaders(t *testing.T) {
+	base, _ := NewRateLimiter(100, time.Second, RateLimitBlock)
+	limiter, _ := NewAdaptiveLimiter(base, AdaptiveConfig{})
+
+	// A quota running low without a 429 leaves the limits alone
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "1000")
+	header.Set("X-RateLimit-Remaining", "20")
+	limiter.ObserveResponse(http.StatusOK, header)
+	if base.Limit() != 100 {
+		t.Fatalf("Expected limit unchanged on low remaining quota, got %d", base.Limit())
+	}
+
+	if _, err := NewAdaptiveLimiter(&countingLimiter{}, AdaptiveConfig{}); err == nil {
+		t.Error("Expected error for unsupported limiter")
+	}
+}
+
+func TestHTTPClientAdaptiveRateLimit(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"message":"Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:            "test-key",
+		BaseURL:           server.URL,
+		RetryPolicy:       &RetryPolicy{InitialBackoff: time.Millisecond},
+		AdaptiveRateLimit: &AdaptiveConfig{IncreaseInterval: time.Hour},
+	})
+
+	if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if status := client.GetLimiterStatus(EndpointDefiPrice); status[0].Limit != 150 {
+		t.Errorf("Expected market data limit halved to 150, got %+v", status)
+	}
+	if status := client.GetLimiterStatus(EndpointDefiTokenList); status[0].Limit != 150 {
+		t.Errorf("Expected token list limit untouched, got %+v", status)
+	}
+}
@@ -0,0 +1,242 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Micro-batching of single-address calls.
+//
+// With HTTPClientConfig.Batching set, GetTokenPrice, GetTokenMetadata,
+// GetTokenMarketData and GetTokenTradeData do not query their single-address
+// endpoint. Calls sharing the same options are collected for a short window
+// and sent as one request to the matching multiple-address endpoint, whose
+// response is fanned back out to each caller.
+//
+// A batch request does not carry the context of any caller: it runs with the
+// highest priority among its callers, and each caller gets its own CacheInfo
+// and ResponseMeta. Calls using WithCacheBypass or WithoutCoalescing are not
+// batched.
+
+// ============================================================================
+// Batch Configuration
+// ============================================================================
+
+// DefaultBatchWindow is how long single-address calls are collected by default.
+const DefaultBatchWindow = 10 * time.Millisecond
+
+// DefaultBatchSizes holds the maximum number of addresses per request of each
+// multiple-address endpoint used for batching.
+var DefaultBatchSizes = map[string]int{
+	EndpointDefiMultiPrice:                100,
+	EndpointDefiV3TokenMetadataMultiple:   50,
+	EndpointDefiV3TokenMarketDataMultiple: 20,
+	EndpointDefiV3TokenTradeDataMultiple:  20,
+}
+
+// BatchConfig configures the micro-batching of single-address calls.
+type BatchConfig struct {
+	// Window is how long calls are collected before their batch is sent.
+	// A full batch is sent at once.
+	// Optional, default: DefaultBatchWindow
+	Window time.Duration
+
+	// MaxSizes overrides DefaultBatchSizes. A size below 2 disables batching
+	// for the endpoint.
+	// Optional, default: nil (DefaultBatchSizes)
+	MaxSizes map[string]int
+}
+
+// ============================================================================
+// microBatcher
+// ============================================================================
+
+// batchResult is the share of a batch response for one address.
+type batchResult struct {
+	raw   json.RawMessage // nil if the batch was skipped by the rate limiter
+	err   error
+	body  []byte    // Whole batch response, nil if skipped
+	cache CacheInfo // Origin of the batch response
+}
+
+// pendingBatch is a batch collecting addresses.
+type pendingBatch struct {
+	priority  Priority // Highest priority among the callers
+	endpoint  string
+	opts      requestOptions // Request options without the addresses
+	addresses []string
+	waiters   map[string][]chan batchResult
+}
+
+// microBatcher collects single-address calls into multiple-address requests.
+// A nil microBatcher batches nothing.
+type microBatcher struct {
+	client *HTTPClient
+	window time.Duration
+	sizes  map[string]int
+
+	mu      sync.Mutex
+	pending map[string]*pendingBatch
+}
+
+// newMicroBatcher creates the batcher, nil if batching is disabled.
+func newMicroBatcher(client *HTTPClient, config *BatchConfig) *microBatcher {
+	if config == nil {
+		return nil
+	}
+	b := &microBatcher{
+		client:  client,
+		window:  config.Window,
+		sizes:   maps.Clone(DefaultBatchSizes),
+		pending: make(map[string]*pendingBatch),
+	}
+	if b.window <= 0 {
+		b.window = DefaultBatchWindow
+	}
+	maps.Copy(b.sizes, config.MaxSizes)
+	return b
+}
+
+// enabled reports whether calls are batched into the endpoint.
+func (b *microBatcher) enabled(endpoint string) bool {
+	return b != nil && b.sizes[endpoint] > 1
+}
+
+// load adds an address to the batch of calls sharing its endpoint and options
+// and waits for its share of the response.
+func (b *microBatcher) load(ctx context.Context, endpoint, address string, opts requestOptions) (json.RawMessage, error) {
+	key := requestKey(endpoint, b.client.requestChains(opts), opts) + "|" + string(opts.onLimitExceeded)
+	result := make(chan batchResult, 1)
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &pendingBatch{
+			priority: PriorityFromContext(ctx),
+			endpoint: endpoint,
+			opts:     opts,
+			waiters:  make(map[string][]chan batchResult),
+		}
+		b.pending[key] = batch
+		go b.flushAfterWindow(key, batch)
+	}
+	batch.priority = max(batch.priority, PriorityFromContext(ctx))
+	if _, seen := batch.waiters[address]; !seen {
+		batch.addresses = append(batch.addresses, address)
+	}
+	batch.waiters[address] = append(batch.waiters[address], result)
+	full := len(batch.addresses) >= b.sizes[endpoint]
+	if full {
+		delete(b.pending, key)
+	}
+	b.mu.Unlock()
+
+	if full {
+		go b.send(batch)
+	}
+
+	select {
+	case res := <-result:
+		if res.body != nil {
+			reportCacheInfo(ctx, res.cache)
+			reportResponseMeta(ctx, res.body)
+		}
+		return res.raw, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flushAfterWindow sends a batch once the window has passed, unless it was sent when full.
+func (b *microBatcher) flushAfterWindow(key string, batch *pendingBatch) {
+	timer := b.client.clock.NewTimer(b.window)
+	<-timer.C()
+
+	b.mu.Lock()
+	if b.pending[key] != batch {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	b.send(batch)
+}
+
+// send requests a batch and hands each caller the item of its address.
+func (b *microBatcher) send(batch *pendingBatch) {
+	opts := batch.opts
+	opts.paramsOrBody = maps.Clone(opts.paramsOrBody)
+	// Sorted, so that batches of the same addresses share a cache entry
+	opts.paramsOrBody["list_address"] = slices.Sorted(slices.Values(batch.addresses))
+
+	// The request belongs to no caller: it carries none of their context values
+	var info CacheInfo
+	ctx := WithCacheInfo(WithPriority(context.Background(), batch.priority), &info)
+
+	var items map[string]json.RawMessage
+	body, err := b.client.do(ctx, batch.endpoint, opts)
+	if err == nil && body != nil {
+		items, err = decodeData[map[string]json.RawMessage](body)
+	}
+
+	for address, waiters := range batch.waiters {
+		res := batchResult{err: err, body: body, cache: info}
+		if err == nil && body != nil {
+			raw, ok := lookupAddress(items, address)
+			if !ok {
+				res.err = fmt.Errorf("%w: %s is missing from the %s response", ErrNotFound, address, batch.endpoint)
+			}
+			res.raw = raw
+		}
+		for _, waiter := range waiters {
+			waiter <- res
+		}
+	}
+}
+
+// lookupAddress returns the non-null item of an address, matching EVM
+// addresses regardless of case.
+func lookupAddress(items map[string]json.RawMessage, address string) (json.RawMessage, bool) {
+	raw, ok := items[address]
+	if !ok {
+		for k, v := range items {
+			if strings.EqualFold(k, address) {
+				raw, ok = v, true
+				break
+			}
+		}
+	}
+	if !ok || string(raw) == "null" {
+		return nil, false
+	}
+	return raw, true
+}
+
+// requestBatched requests the data of a single address, through a batch to
+// batchEndpoint if batching is enabled for it.
+//
+// params must not hold the address; it is added for unbatched requests.
+// Calls bypassing the cache or opting out of coalescing are sent on their own.
+func requestBatched[T any](ctx context.Context, c *HTTPClient, endpoint, batchEndpoint, address string, opts requestOptions) (T, error) {
+	if !c.batcher.enabled(batchEndpoint) || cacheBypassed(ctx) || coalescingDisabled(ctx) {
+		opts.paramsOrBody["address"] = address
+		return requestData[T](ctx, c, endpoint, opts)
+	}
+
+	var out T
+	raw, err := c.batcher.load(ctx, batchEndpoint, address, opts)
+	if err != nil || raw == nil {
+		return out, err
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return out, err
+	}
+	return out, nil
+}
@@ -0,0 +1,148 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// batchHandler answers multiple-address requests for every address except "missing".
+func batchHandler(w http.ResponseWriter, r *http.Request) {
+	data := make(map[string]any)
+	for i, address := range strings.Split(r.URL.Query().Get("list_address"), ",") {
+		if address != "missing" {
+			data[address] = map[string]any{"value": i + 1, "name": address}
+		}
+	}
+	json.NewEncoder(w).Encode(map[string]any{"success": true, "data": data})
+}
+
+func TestHTTPClientBatchFull(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Clock:    NewManualClock(time.Now()),
+		Batching: &BatchConfig{MaxSizes: map[string]int{EndpointDefiMultiPrice: 3}},
+	}, batchHandler)
+
+	addresses := []string{testTokenSOL, "other-token", "missing"}
+	prices := make([]*RespTokenPrice, len(addresses))
+	errs := make([]error, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			prices[i], errs[i] = client.GetTokenPrice(context.Background(), address, nil)
+		}()
+	}
+	wg.Wait()
+
+	// The full batch is sent without waiting for the window
+	if lists := rec.query(EndpointDefiMultiPrice, "list_address"); len(lists) != 1 || len(strings.Split(lists[0], ",")) != 3 {
+		t.Fatalf("Expected one request with 3 addresses, got %v", lists)
+	}
+	for i := range 2 {
+		if errs[i] != nil || prices[i] == nil || prices[i].Value == 0 {
+			t.Fatalf("Expected the price of %s, got %+v, %v", addresses[i], prices[i], errs[i])
+		}
+	}
+	if !errors.Is(errs[2], ErrNotFound) {
+		t.Fatalf("Expected a not-found error for the missing address, got %v", errs[2])
+	}
+}
+
+func TestHTTPClientBatchWindow(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Clock:    clock,
+		Batching: &BatchConfig{Window: 50 * time.Millisecond},
+	}, batchHandler)
+
+	done := make(chan error, 1)
+	go func() {
+		metadata, err := client.GetTokenMetadata(context.Background(), testTokenSOL, nil)
+		if err == nil && metadata.Name != testTokenSOL {
+			err = errors.New("unexpected metadata " + metadata.Name)
+		}
+		done <- err
+	}()
+
+	// The batch is sent once the window has passed
+	clock.BlockUntil(1)
+	if rec.count() != 0 {
+		t.Fatalf("Expected no request within the window, got %d", rec.count())
+	}
+	clock.Advance(50 * time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if lists := rec.query(EndpointDefiV3TokenMetadataMultiple, "list_address"); len(lists) != 1 || lists[0] != testTokenSOL {
+		t.Fatalf("Expected a batch request, got %v", lists)
+	}
+}
+
+func TestHTTPClientBatchDisabled(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Batching: &BatchConfig{MaxSizes: map[string]int{EndpointDefiV3TokenMarketDataMultiple: 0}},
+	}, batchHandler)
+
+	if _, err := client.GetTokenMarketData(context.Background(), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.query(EndpointDefiV3TokenMarketData, "address")) != 1 {
+		t.Fatalf("Expected the single-address endpoint, got %v", rec.query("", "address"))
+	}
+}
+
+func TestHTTPClientBatchContext(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Cache:    &CacheConfig{},
+		Batching: &BatchConfig{MaxSizes: map[string]int{EndpointDefiV3TokenMarketDataMultiple: 2}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == EndpointDefiV3TokenMarketData {
+			okResponse(w, r)
+			return
+		}
+		batchHandler(w, r)
+	})
+
+	// Each caller of a batch gets its own cache info
+	fetchPair := func() [2]CacheInfo {
+		infos := [2]CacheInfo{{Stale: true}, {Stale: true}}
+		var wg sync.WaitGroup
+		for i, address := range []string{testTokenSOL, testTokenUSDC} {
+			wg.Go(func() {
+				ctx := WithCacheInfo(WithPriority(context.Background(), Priority(i)), &infos[i])
+				if _, err := client.GetTokenMarketData(ctx, address, nil); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+		wg.Wait()
+		return infos
+	}
+	if infos := fetchPair(); infos[0] != (CacheInfo{}) || infos[1] != (CacheInfo{}) {
+		t.Fatalf("Expected both callers to see an API response, got %+v", infos)
+	}
+	if infos := fetchPair(); !infos[0].Cached || !infos[1].Cached {
+		t.Fatalf("Expected both callers to see a cached response, got %+v", infos)
+	}
+	if n := rec.count(); n != 1 {
+		t.Fatalf("Expected 1 batch request, got %d", n)
+	}
+
+	// Bypassing the cache or coalescing skips the batch
+	if _, err := client.GetTokenMarketData(WithCacheBypass(context.Background()), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetTokenMarketData(WithoutCoalescing(context.Background()), testTokenUSDC, nil); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(rec.query(EndpointDefiV3TokenMarketData, "address")); n != 2 {
+		t.Fatalf("Expected 2 single-address requests, got %d", n)
+	}
+}
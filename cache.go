@@ -0,0 +1,467 @@
+package birdeye
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Response caching.
+//
+// Much of the data served by Birdeye, such as token metadata or creation
+// info, hardly ever changes. With HTTPClientConfig.Cache set, successful
+// responses are kept in a Cache for a time-to-live chosen per endpoint, and
+// identical requests are answered from it without spending rate limit tokens
+// or compute units.
+//
+// Past their TTL, entries can still stand in for the API for CacheConfig.MaxStale:
+// served at once while a background call refreshes them (stale-while-revalidate),
+// or when the API fails (stale-if-error). WithCacheInfo tells callers whether a
+// response is stale.
+
+// ============================================================================
+// Cache Interface
+// ============================================================================
+
+// CacheEntry is a cached response body.
+type CacheEntry struct {
+	Body     []byte    // Raw response body
+	StoredAt time.Time // When the response was received
+
+	// ExpiresAt is when the client stops using the entry. Implementations may
+	// drop it from then on, e.g. through a TTL in an external store.
+	ExpiresAt time.Time
+}
+
+// Cache stores response bodies for HTTPClient.
+//
+// Implementations must be safe for concurrent use. The client decides on its
+// own whether an entry is still usable, so a Cache only needs to store and
+// evict entries. Plug in external stores (e.g. Redis) through CacheConfig.Store.
+type Cache interface {
+	// Get returns the entry stored under key.
+	Get(key string) (CacheEntry, bool)
+
+	// Set stores an entry under key, replacing any previous one.
+	Set(key string, entry CacheEntry)
+
+	// Delete removes the entry stored under key, if any.
+	Delete(key string)
+}
+
+var _ Cache = (*LRUCache)(nil)
+
+// ============================================================================
+// Cache Configuration
+// ============================================================================
+
+// DefaultCacheCapacity is the number of entries of the default LRUCache.
+const DefaultCacheCapacity = 10000
+
+// DefaultCacheMaxStale is how long entries are served past their TTL by default
+// when StaleWhileRevalidate or StaleIfError is enabled.
+const DefaultCacheMaxStale = 5 * time.Minute
+
+// DefaultCacheTTLs holds how long responses of each endpoint are cached.
+//
+// Endpoints not listed, such as transactions, wallets and lists, are never cached.
+var DefaultCacheTTLs = map[string]time.Duration{
+	// Static data
+	EndpointDefiNetworks:                24 * time.Hour,
+	EndpointV1WalletListSupportedChain:  24 * time.Hour,
+	EndpointDefiV3TokenMetadataSingle:   24 * time.Hour,
+	EndpointDefiV3TokenMetadataMultiple: 24 * time.Hour,
+	EndpointDefiTokenCreationInfo:       24 * time.Hour,
+	EndpointDefiTokenSecurity:           time.Hour,
+	EndpointDefiHistoricalPriceUnix:     time.Hour,
+
+	// Market data
+	EndpointDefiPrice:                     time.Second,
+	EndpointDefiMultiPrice:                time.Second,
+	EndpointDefiPriceVolumeSingle:         time.Second,
+	EndpointDefiPriceVolumeMulti:          time.Second,
+	EndpointDefiV3TokenMarketData:         5 * time.Second,
+	EndpointDefiV3TokenMarketDataMultiple: 5 * time.Second,
+	EndpointDefiTokenOverview:             10 * time.Second,
+	EndpointDefiV3TokenTradeDataSingle:    10 * time.Second,
+	EndpointDefiV3TokenTradeDataMultiple:  10 * time.Second,
+	EndpointDefiV3PairOverviewSingle:      10 * time.Second,
+	EndpointDefiV3PairOverviewMultiple:    10 * time.Second,
+	EndpointDefiV3PriceStatsSingle:        10 * time.Second,
+	EndpointDefiV3PriceStatsMultiple:      10 * time.Second,
+}
+
+// CacheConfig configures the response cache of HTTPClient.
+type CacheConfig struct {
+	// Store holds the cached responses.
+	// Optional, default: NewLRUCache(DefaultCacheCapacity)
+	Store Cache
+
+	// TTLs overrides or extends DefaultCacheTTLs. A TTL of 0 disables caching
+	// for the endpoint.
+	// Optional, default: nil (DefaultCacheTTLs)
+	TTLs map[string]time.Duration
+
+	// StaleWhileRevalidate serves entries past their TTL at once and refreshes
+	// them with a background call.
+	// Optional, default: false
+	StaleWhileRevalidate bool
+
+	// StaleIfError serves entries past their TTL when the API fails with a
+	// network error, a timeout, a 429 or a 5xx status.
+	// Optional, default: false
+	StaleIfError bool
+
+	// MaxStale is how long past its TTL an entry may still be served.
+	// Optional, default: DefaultCacheMaxStale
+	MaxStale time.Duration
+}
+
+// CacheStats counts the lookups of the response cache.
+type CacheStats struct {
+	Hits          int64 // Requests answered with a fresh entry
+	Misses        int64 // Lookups without a fresh entry
+	Stale         int64 // Requests answered with an entry past its TTL
+	Revalidations int64 // Background refreshes of stale entries
+}
+
+// CacheInfo describes where the response of a call came from.
+type CacheInfo struct {
+	Cached bool          // The response was served from the cache
+	Stale  bool          // The response is past its TTL
+	Age    time.Duration // Time since the response was received from the API
+
+	// Err is the API error a stale response stands in for, nil if none
+	Err error
+}
+
+type cacheInfoKey struct{}
+
+// WithCacheInfo returns a context recording into info where the response of a
+// call came from. Use it to decide whether stale data is acceptable.
+//
+// Example:
+//
+//	var info birdeye.CacheInfo
+//	overview, err := client.GetTokenOverview(birdeye.WithCacheInfo(ctx, &info), address, nil)
+//	if err == nil && info.Stale {
+//	    log.Printf("token overview is %s old: %v", info.Age, info.Err)
+//	}
+func WithCacheInfo(ctx context.Context, info *CacheInfo) context.Context {
+	return context.WithValue(ctx, cacheInfoKey{}, info)
+}
+
+// reportCacheInfo records the origin of a response into the context's CacheInfo, if any.
+func reportCacheInfo(ctx context.Context, info CacheInfo) {
+	if dst, ok := ctx.Value(cacheInfoKey{}).(*CacheInfo); ok && dst != nil {
+		*dst = info
+	}
+}
+
+type cacheBypassKey struct{}
+
+// WithCacheBypass returns a context whose requests skip the cache lookup and
+// always reach the API. Their responses still refresh the cache.
+//
+// Example:
+//
+//	// Force fresh data after a known update
+//	security, err := client.GetTokenSecurity(birdeye.WithCacheBypass(ctx), address, nil)
+func WithCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+// cacheBypassed reports whether the context skips the cache lookup.
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// ============================================================================
+// responseCache
+// ============================================================================
+
+// cacheState is the state of a request's cache entry.
+type cacheState int
+
+const (
+	cacheMiss  cacheState = iota // No usable entry
+	cacheFresh                   // Entry within its TTL
+	cacheStale                   // Entry past its TTL but within MaxStale
+)
+
+// responseCache caches response bodies of an HTTPClient. A nil responseCache caches nothing.
+type responseCache struct {
+	store                Cache
+	ttls                 map[string]time.Duration
+	staleWhileRevalidate bool
+	staleIfError         bool
+	maxStale             time.Duration // 0 unless stale entries are served
+	clock                Clock
+	refreshing           sync.Map // Keys being revalidated in the background
+
+	hits          atomic.Int64
+	misses        atomic.Int64
+	stale         atomic.Int64
+	revalidations atomic.Int64
+}
+
+// newResponseCache creates the response cache, nil if caching is disabled.
+func newResponseCache(config *CacheConfig, clock Clock) *responseCache {
+	if config == nil {
+		return nil
+	}
+	rc := &responseCache{
+		store:                config.Store,
+		ttls:                 maps.Clone(DefaultCacheTTLs),
+		staleWhileRevalidate: config.StaleWhileRevalidate,
+		staleIfError:         config.StaleIfError,
+		clock:                clock,
+	}
+	if rc.store == nil {
+		rc.store = NewLRUCache(DefaultCacheCapacity)
+	}
+	if rc.staleWhileRevalidate || rc.staleIfError {
+		rc.maxStale = config.MaxStale
+		if rc.maxStale <= 0 {
+			rc.maxStale = DefaultCacheMaxStale
+		}
+	}
+	maps.Copy(rc.ttls, config.TTLs)
+	return rc
+}
+
+// ttl returns how long responses of the endpoint are cached, 0 if they are not.
+func (rc *responseCache) ttl(endpoint string) time.Duration {
+	if rc == nil {
+		return 0
+	}
+	return rc.ttls[endpoint]
+}
+
+// lookup returns the cached entry of a request and whether it is fresh or stale.
+func (rc *responseCache) lookup(key, endpoint string) (CacheEntry, cacheState) {
+	entry, ok := rc.store.Get(key)
+	now := rc.clock.Now()
+	switch {
+	case !ok || !now.Before(entry.ExpiresAt):
+		rc.misses.Add(1)
+		return CacheEntry{}, cacheMiss
+	case now.Before(entry.StoredAt.Add(rc.ttl(endpoint))):
+		rc.hits.Add(1)
+		return entry, cacheFresh
+	default:
+		rc.misses.Add(1)
+		return entry, cacheStale
+	}
+}
+
+// set caches the body of a successful response, kept for its TTL plus MaxStale.
+func (rc *responseCache) set(key, endpoint string, body []byte) {
+	now := rc.clock.Now()
+	rc.store.Set(key, CacheEntry{
+		Body:      body,
+		StoredAt:  now,
+		ExpiresAt: now.Add(rc.ttl(endpoint) + rc.maxStale),
+	})
+}
+
+// serveStale counts a stale entry served and returns its CacheInfo.
+func (rc *responseCache) serveStale(entry CacheEntry, err error) CacheInfo {
+	rc.stale.Add(1)
+	return CacheInfo{Cached: true, Stale: true, Age: rc.clock.Now().Sub(entry.StoredAt), Err: err}
+}
+
+// stats returns the lookup counters.
+func (rc *responseCache) stats() CacheStats {
+	if rc == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Hits:          rc.hits.Load(),
+		Misses:        rc.misses.Load(),
+		Stale:         rc.stale.Load(),
+		Revalidations: rc.revalidations.Load(),
+	}
+}
+
+// isUpstreamFailure reports whether err means the API could not serve a
+// request, so that a stale response may stand in for it.
+func isUpstreamFailure(err error) bool {
+	return errors.Is(err, ErrNetwork) ||
+		errors.Is(err, ErrTimeout) ||
+		errors.Is(err, ErrInternalServer) ||
+		errors.Is(err, ErrTooManyRequests) ||
+		errors.Is(err, context.DeadlineExceeded)
+}
+
+// doCached sends a cacheable request through the response cache.
+func (c *HTTPClient) doCached(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
+	rc := c.cache
+	key := requestKey(endpoint, c.requestChains(opts), opts)
+
+	var entry CacheEntry
+	state := cacheMiss
+	if !cacheBypassed(ctx) {
+		entry, state = rc.lookup(key, endpoint)
+		switch {
+		case state == cacheFresh:
+			reportCacheInfo(ctx, CacheInfo{Cached: true, Age: rc.clock.Now().Sub(entry.StoredAt)})
+			return entry.Body, nil
+		case state == cacheStale && rc.staleWhileRevalidate:
+			c.revalidate(ctx, key, endpoint, opts)
+			reportCacheInfo(ctx, rc.serveStale(entry, nil))
+			return entry.Body, nil
+		}
+	}
+
+	body, err := c.fetchShared(ctx, endpoint, opts)
+	if body != nil {
+		rc.set(key, endpoint, body)
+		reportCacheInfo(ctx, CacheInfo{})
+		return body, err
+	}
+	if state == cacheStale && rc.staleIfError && isUpstreamFailure(err) {
+		reportCacheInfo(ctx, rc.serveStale(entry, err))
+		return entry.Body, nil
+	}
+	return body, err
+}
+
+// revalidate refreshes a stale entry in the background, once per key at a time.
+// The refresh keeps the values of ctx but outlives its cancellation.
+func (c *HTTPClient) revalidate(ctx context.Context, key, endpoint string, opts requestOptions) {
+	rc := c.cache
+	if _, busy := rc.refreshing.LoadOrStore(key, struct{}{}); busy {
+		return
+	}
+	rc.revalidations.Add(1)
+
+	go func() {
+		defer rc.refreshing.Delete(key)
+		if body, _ := c.fetchShared(context.WithoutCancel(ctx), endpoint, opts); body != nil {
+			rc.set(key, endpoint, body)
+		}
+	}()
+}
+
+// requestKey identifies a request by method, endpoint, chains and normalized
+// parameters, so that equivalent requests share a cache entry.
+func requestKey(endpoint string, chains []Chain, opts requestOptions) string {
+	var params string
+	if opts.method == "POST" {
+		// Map keys are marshalled in sorted order
+		body, _ := json.Marshal(opts.paramsOrBody)
+		params = string(body)
+	} else {
+		values := url.Values{}
+		for k, v := range opts.paramsOrBody {
+			if arr, ok := v.([]string); ok && !opts.paramsUseArray {
+				v = strings.Join(arr, ",")
+			}
+			values.Add(k, fmt.Sprintf("%v", v))
+		}
+		params = values.Encode()
+	}
+
+	chainStrs := make([]string, len(chains))
+	for i, chain := range chains {
+		chainStrs[i] = string(chain)
+	}
+	method := opts.method
+	if method == "" {
+		method = "GET"
+	}
+	return method + " " + endpoint + "|" + strings.Join(chainStrs, ",") + "|" + params
+}
+
+// ============================================================================
+// LRUCache
+// ============================================================================
+
+// LRUCache is an in-memory Cache evicting the least recently used entry once
+// it holds capacity entries.
+type LRUCache struct {
+	capacity int
+	mu       sync.Mutex
+	items    map[string]*list.Element
+	order    *list.List // Front is the most recently used
+}
+
+// lruItem is an entry of LRUCache.
+type lruItem struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewLRUCache creates an in-memory LRU cache.
+//
+// Args:
+//   - capacity: Maximum number of entries, DefaultCacheCapacity if not positive
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity <= 0 {
+		capacity = DefaultCacheCapacity
+	}
+	return &LRUCache{
+		capacity: capacity,
+		items:    make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the entry stored under key and marks it as recently used.
+func (c *LRUCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruItem).entry, true
+}
+
+// Set stores an entry under key, evicting the least recently used entry if full.
+func (c *LRUCache) Set(key string, entry CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruItem{key: key, entry: entry})
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete removes the entry stored under key.
+func (c *LRUCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of entries.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,216 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", CacheEntry{Body: []byte("1")})
+	cache.Set("b", CacheEntry{Body: []byte("2")})
+
+	// Reading a marks it as recently used, so b is evicted
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("Expected a to be cached")
+	}
+	cache.Set("c", CacheEntry{Body: []byte("3")})
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("Expected b to be evicted")
+	}
+	if entry, ok := cache.Get("a"); !ok || string(entry.Body) != "1" {
+		t.Fatalf("Expected a to survive, got %q %v", entry.Body, ok)
+	}
+
+	cache.Set("a", CacheEntry{Body: []byte("4")})
+	if entry, _ := cache.Get("a"); string(entry.Body) != "4" {
+		t.Fatalf("Expected a to be replaced, got %q", entry.Body)
+	}
+	cache.Delete("a")
+	if _, ok := cache.Get("a"); ok || cache.Len() != 1 {
+		t.Fatalf("Expected a to be deleted, %d entries left", cache.Len())
+	}
+}
+
+func TestRequestKey(t *testing.T) {
+	a := requestKey(EndpointDefiMultiPrice, []Chain{ChainSolana}, requestOptions{
+		paramsOrBody: map[string]any{"list_address": []string{"x", "y"}, "check_liquidity": 10},
+	})
+	b := requestKey(EndpointDefiMultiPrice, []Chain{ChainSolana}, requestOptions{
+		paramsOrBody: map[string]any{"check_liquidity": "10", "list_address": "x,y"},
+	})
+	if a != b {
+		t.Fatalf("Expected equivalent params to share a key:\n%s\n%s", a, b)
+	}
+	if c := requestKey(EndpointDefiMultiPrice, []Chain{ChainEthereum}, requestOptions{
+		paramsOrBody: map[string]any{"check_liquidity": "10", "list_address": "x,y"},
+	}); c == a {
+		t.Fatal("Expected the chain to be part of the key")
+	}
+}
+
+func TestHTTPClientCache(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Clock: clock,
+		Cache: &CacheConfig{
+			TTLs: map[string]time.Duration{EndpointDefiPrice: time.Minute},
+		},
+	}, okResponse)
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rec.count() != 1 {
+		t.Fatalf("Expected 1 call within the TTL, got %d", rec.count())
+	}
+	if usage := client.GetComputeUnitUsage(); usage.Endpoints[EndpointDefiPrice].Calls != 1 {
+		t.Fatalf("Expected cache hits not to be billed, got %+v", usage.Endpoints[EndpointDefiPrice])
+	}
+
+	// Other params miss the cache
+	if _, err := client.GetTokenPrice(ctx, "other-token", nil); err != nil {
+		t.Fatal(err)
+	}
+	if rec.count() != 2 {
+		t.Fatalf("Expected 2 calls, got %d", rec.count())
+	}
+
+	// Bypassing skips the lookup
+	if _, err := client.GetTokenPrice(WithCacheBypass(ctx), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if rec.count() != 3 {
+		t.Fatalf("Expected the bypass to reach the API, got %d calls", rec.count())
+	}
+
+	clock.Advance(time.Minute)
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if rec.count() != 4 {
+		t.Fatalf("Expected the entry to expire, got %d calls", rec.count())
+	}
+
+	// Endpoints without a TTL are never cached
+	for range 2 {
+		if _, err := client.GetTokenTxs(ctx, testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if rec.count() != 6 {
+		t.Fatalf("Expected txs not to be cached, got %d calls", rec.count())
+	}
+
+	if stats := client.GetCacheStats(); stats.Hits != 2 || stats.Misses != 3 {
+		t.Fatalf("Expected 2 hits and 3 misses, got %+v", stats)
+	}
+}
+
+// newStaleCacheClient creates a caching client against a server that fails while failing is set.
+func newStaleCacheClient(t *testing.T, config CacheConfig) (*HTTPClient, *ManualClock, *requestRecorder, *atomic.Bool) {
+	t.Helper()
+	var failing atomic.Bool
+	var rec *requestRecorder
+	clock := NewManualClock(time.Now())
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		Clock:       clock,
+		RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+		Cache:       &config,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`{"success":false,"message":"Bad Gateway"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"success":true,"data":{"price":%d}}`, rec.count())
+	})
+	return client, clock, rec, &failing
+}
+
+func TestHTTPClientStaleIfError(t *testing.T) {
+	client, clock, rec, failing := newStaleCacheClient(t, CacheConfig{
+		TTLs:         map[string]time.Duration{EndpointDefiTokenOverview: time.Minute},
+		StaleIfError: true,
+		MaxStale:     time.Hour,
+	})
+	ctx := context.Background()
+
+	var info CacheInfo
+	if _, err := client.GetTokenOverview(WithCacheInfo(ctx, &info), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if info.Cached || info.Stale {
+		t.Fatalf("Expected a response from the API, got %+v", info)
+	}
+
+	// Past the TTL, a failing API is answered with the stale entry
+	failing.Store(true)
+	clock.Advance(2 * time.Minute)
+	overview, err := client.GetTokenOverview(WithCacheInfo(ctx, &info), testTokenSOL, nil)
+	if err != nil {
+		t.Fatalf("Expected the stale entry, got %v", err)
+	}
+	if overview.Price != 1 {
+		t.Fatalf("Expected the cached price, got %v", overview.Price)
+	}
+	if !info.Cached || !info.Stale || info.Age != 2*time.Minute || !errors.Is(info.Err, ErrInternalServer) {
+		t.Fatalf("Expected a stale marker with the upstream error, got %+v", info)
+	}
+
+	// Past MaxStale, the error is returned
+	clock.Advance(time.Hour)
+	if _, err := client.GetTokenOverview(ctx, testTokenSOL, nil); !errors.Is(err, ErrInternalServer) {
+		t.Fatalf("Expected the upstream error past MaxStale, got %v", err)
+	}
+	if rec.count() != 3 {
+		t.Fatalf("Expected 3 calls, got %d", rec.count())
+	}
+	if stats := client.GetCacheStats(); stats.Stale != 1 {
+		t.Fatalf("Expected 1 stale response, got %+v", stats)
+	}
+}
+
+func TestHTTPClientStaleWhileRevalidate(t *testing.T) {
+	client, clock, rec, _ := newStaleCacheClient(t, CacheConfig{
+		TTLs:                 map[string]time.Duration{EndpointDefiV3PairOverviewSingle: time.Minute},
+		StaleWhileRevalidate: true,
+	})
+	ctx := context.Background()
+
+	if _, err := client.GetPairOverview(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(2 * time.Minute)
+
+	// The stale entry is returned at once and refreshed in the background
+	var info CacheInfo
+	if _, err := client.GetPairOverview(WithCacheInfo(ctx, &info), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !info.Stale || info.Err != nil {
+		t.Fatalf("Expected a stale response, got %+v", info)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for rec.count() < 2 || client.GetCacheStats().Hits == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the entry to be refreshed, %d calls, %+v", rec.count(), client.GetCacheStats())
+		}
+		client.GetPairOverview(WithCacheInfo(ctx, &info), testTokenSOL, nil)
+		time.Sleep(time.Millisecond)
+	}
+	if info.Stale || !info.Cached {
+		t.Fatalf("Expected the refreshed entry to be fresh, got %+v", info)
+	}
+	if stats := client.GetCacheStats(); stats.Revalidations != 1 || rec.count() != 2 {
+		t.Fatalf("Expected a single revalidation, %d calls, %+v", rec.count(), stats)
+	}
+}
@@ -0,0 +1,209 @@
+package birdeye
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Time source of the rate limiters, the compute unit meter and retries.
+//
+// Everything that waits or measures time in this package reads it from a
+// Clock. Production code uses the system clock. Tests can pass a ManualClock
+// through WithClock or HTTPClientConfig.Clock and move time forward
+// explicitly, so quota-heavy code runs deterministically without sleeping.
+
+// ============================================================================
+// Clock Interface
+// ============================================================================
+
+// Clock provides the current time and timers.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer creates a timer that sends the current time on its channel
+	// after at least duration d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event timer created by a Clock, see time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It returns false if the timer
+	// already fired or was stopped.
+	Stop() bool
+}
+
+var (
+	_ Clock = RealClock{}
+	_ Clock = (*ManualClock)(nil)
+)
+
+// clockOrReal returns the clock, or the system clock if it is nil.
+func clockOrReal(clock Clock) Clock {
+	if clock == nil {
+		return RealClock{}
+	}
+	return clock
+}
+
+// ============================================================================
+// RealClock
+// ============================================================================
+
+// RealClock is the system clock, backed by the time package.
+type RealClock struct{}
+
+// Now returns time.Now().
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer returns a timer backed by time.NewTimer.
+func (RealClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+// realTimer adapts *time.Timer to Timer.
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time { return t.timer.C }
+func (t realTimer) Stop() bool          { return t.timer.Stop() }
+
+// ============================================================================
+// ManualClock
+// ============================================================================
+
+// ManualClock is a fake clock for tests. Its time only moves when Advance or
+// Set is called, which fires the timers that became due.
+//
+// Example:
+//
+//	clock := birdeye.NewManualClock(time.Now())
+//	limiter, _ := birdeye.NewRateLimiter(1, time.Second, birdeye.RateLimitBlock, birdeye.WithClock(clock))
+//	limiter.TryAcquire(1)
+//
+//	done := make(chan struct{})
+//	go func() {
+//	    limiter.Wait(ctx) // blocks on a timer of the manual clock
+//	    close(done)
+//	}()
+//	clock.BlockUntil(1)
+//	clock.Advance(time.Second)
+//	<-done
+type ManualClock struct {
+	mu     sync.Mutex
+	added  *sync.Cond // Broadcast when a timer is created
+	now    time.Time
+	timers []*manualTimer // Pending timers in deadline order
+}
+
+// NewManualClock creates a manual clock set to the given time.
+func NewManualClock(start time.Time) *ManualClock {
+	c := &ManualClock{now: start}
+	c.added = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the current time of the clock.
+func (c *ManualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer creates a timer firing once the clock has advanced by d.
+// A timer with d <= 0 fires immediately.
+func (c *ManualClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &manualTimer{
+		clock:    c,
+		deadline: c.now.Add(d),
+		c:        make(chan time.Time, 1),
+	}
+	if d <= 0 {
+		t.c <- c.now
+		return t
+	}
+
+	i, _ := slices.BinarySearchFunc(c.timers, t, func(a, b *manualTimer) int {
+		if a.deadline.After(b.deadline) {
+			return 1
+		}
+		return -1 // Equal deadlines fire in creation order
+	})
+	c.timers = slices.Insert(c.timers, i, t)
+	c.added.Broadcast()
+	return t
+}
+
+// Advance moves the clock forward by d and fires the timers that became due.
+func (c *ManualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(c.now.Add(d))
+}
+
+// Set moves the clock to t and fires the timers that became due.
+// Moving the clock backwards fires nothing.
+func (c *ManualClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(t)
+}
+
+// set changes the time and fires due timers. The caller must hold the lock.
+func (c *ManualClock) set(t time.Time) {
+	c.now = t
+	i := 0
+	for i < len(c.timers) && !c.timers[i].deadline.After(t) {
+		c.timers[i].c <- t
+		i++
+	}
+	c.timers = c.timers[i:]
+}
+
+// Timers returns the number of timers waiting to fire.
+func (c *ManualClock) Timers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers are waiting to fire, i.e. until
+// the goroutines under test are blocked on the clock.
+func (c *ManualClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.added.Wait()
+	}
+}
+
+// manualTimer is a timer of a ManualClock.
+type manualTimer struct {
+	clock    *ManualClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *manualTimer) C() <-chan time.Time { return t.c }
+
+func (t *manualTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	i := slices.Index(t.clock.timers, t)
+	if i < 0 {
+		return false
+	}
+	t.clock.timers = slices.Delete(t.clock.timers, i, i+1)
+	return true
+}
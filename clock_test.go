@@ -0,0 +1,152 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestManualClock(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewManualClock(start)
+
+	late := clock.NewTimer(2 * time.Second)
+	early := clock.NewTimer(time.Second)
+	stopped := clock.NewTimer(time.Second)
+	if !stopped.Stop() {
+		t.Fatal("Expected Stop to report a pending timer")
+	}
+	if clock.Timers() != 2 {
+		t.Fatalf("Expected 2 pending timers, got %d", clock.Timers())
+	}
+
+	select {
+	case <-clock.NewTimer(0).C():
+	default:
+		t.Fatal("Expected a zero timer to fire immediately")
+	}
+
+	clock.Advance(time.Second)
+	select {
+	case now := <-early.C():
+		if !now.Equal(start.Add(time.Second)) {
+			t.Fatalf("Expected fire time %v, got %v", start.Add(time.Second), now)
+		}
+	default:
+		t.Fatal("Expected the 1s timer to fire")
+	}
+	select {
+	case <-late.C():
+		t.Fatal("Expected the 2s timer to be pending")
+	case <-stopped.C():
+		t.Fatal("Expected the stopped timer not to fire")
+	default:
+	}
+
+	clock.Set(start.Add(time.Minute))
+	<-late.C()
+	if late.Stop() {
+		t.Fatal("Expected Stop to report a fired timer")
+	}
+	if !clock.Now().Equal(start.Add(time.Minute)) {
+		t.Fatalf("Expected %v, got %v", start.Add(time.Minute), clock.Now())
+	}
+}
+
+func TestRateLimiterManualClock(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	rl, _ := NewRateLimiter(2, time.Second, RateLimitBlock, WithClock(clock))
+
+	if !rl.TryAcquire(2) || rl.TryAcquire(1) {
+		t.Fatal("Expected exactly 2 tokens")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := rl.Acquire(context.Background(), 1, nil)
+		done <- err
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(499 * time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("Expected Acquire to block until the token is refilled")
+	default:
+	}
+
+	clock.Advance(time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	clock.Advance(time.Second)
+	if tokens := rl.GetAvailableTokens(); tokens != 2 {
+		t.Fatalf("Expected 2 tokens after a period, got %v", tokens)
+	}
+}
+
+func TestMultiRateLimiterManualClock(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	mrl, _ := NewMultiRateLimiter([]RateLimit{
+		{Limit: 30, Period: time.Second},
+		{Limit: 150, Period: time.Minute, Algorithm: RateLimitSlidingWindow},
+	}, RateLimitSkip, WithClock(clock))
+
+	// Call as fast as allowed for a minute, checking once per second
+	calls := 0
+	for range 60 {
+		for mrl.TryAcquire(1) {
+			calls++
+		}
+		clock.Advance(time.Second)
+	}
+	if calls != 150 {
+		t.Fatalf("Expected 150 calls in a minute, got %d", calls)
+	}
+
+	// The first calls leave the window after exactly one minute
+	if !mrl.TryAcquire(1) {
+		t.Fatal("Expected a call once the window slid")
+	}
+}
+
+func TestHTTPClientManualClock(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"message":"Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	clock := NewManualClock(time.Now())
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		Clock:   clock,
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+		done <- err
+	}()
+
+	// The retry waits 30s on the manual clock
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Second)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("Expected 2 calls, got %d", calls.Load())
+	}
+}
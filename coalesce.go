@@ -0,0 +1,143 @@
+package birdeye
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Request coalescing.
+//
+// With HTTPClientConfig.CoalesceRequests set, identical requests (same
+// endpoint, chains and parameters) made while one of them is in flight do not
+// reach the API: they wait for the call in flight and share its response, so
+// only one rate limit token and one charge of compute units are spent.
+
+// ============================================================================
+// Coalescing Options
+// ============================================================================
+
+// CoalesceStats counts the requests seen by request coalescing.
+type CoalesceStats struct {
+	Upstream     int64 // Requests sent to the API
+	Deduplicated int64 // Requests that shared the response of a request in flight
+}
+
+type noCoalesceKey struct{}
+
+// WithoutCoalescing returns a context whose requests are always sent on their
+// own, even when an identical request is in flight.
+//
+// Example:
+//
+//	// Do not share the response of a request sent before an update
+//	price, err := client.GetTokenPrice(birdeye.WithoutCoalescing(ctx), address, nil)
+func WithoutCoalescing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCoalesceKey{}, true)
+}
+
+// coalescingDisabled reports whether the context opts out of coalescing.
+func coalescingDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noCoalesceKey{}).(bool)
+	return disabled
+}
+
+// ============================================================================
+// flightGroup
+// ============================================================================
+
+// flightCall is a request in flight.
+type flightCall struct {
+	done     chan struct{} // Closed once body, err and canceled are set
+	body     []byte
+	err      error
+	canceled bool // The request failed with the context of its caller done
+}
+
+// flightGroup collapses identical concurrent requests into one. A nil
+// flightGroup sends every request on its own.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+
+	upstream     atomic.Int64
+	deduplicated atomic.Int64
+}
+
+// newFlightGroup creates the flight group, nil if coalescing is disabled.
+func newFlightGroup(enabled bool) *flightGroup {
+	if !enabled {
+		return nil
+	}
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// do runs fn for the first caller of a key and hands its result to the
+// callers arriving while it runs.
+//
+// A caller stops waiting when its context is done. If the request failed
+// only because the context of the first caller was done, waiting callers
+// whose context is still alive run fn themselves.
+func (g *flightGroup) do(ctx context.Context, key string, fn func() ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		g.deduplicated.Add(1)
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if call.canceled && ctx.Err() == nil {
+			g.upstream.Add(1)
+			return fn()
+		}
+		return call.body, call.err
+	}
+
+	call := &flightCall{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+	g.upstream.Add(1)
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+	call.body, call.err = fn()
+	call.canceled = call.err != nil && ctx.Err() != nil
+	return call.body, call.err
+}
+
+// stats returns the coalescing counters.
+func (g *flightGroup) stats() CoalesceStats {
+	if g == nil {
+		return CoalesceStats{}
+	}
+	return CoalesceStats{Upstream: g.upstream.Load(), Deduplicated: g.deduplicated.Load()}
+}
+
+// fetchShared fetches a request, sharing the response of an identical request in flight.
+func (c *HTTPClient) fetchShared(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
+	if c.flights == nil || coalescingDisabled(ctx) {
+		return c.fetch(ctx, endpoint, opts)
+	}
+	key := requestKey(endpoint, c.requestChains(opts), opts)
+	return c.flights.do(ctx, key, func() ([]byte, error) {
+		return c.fetch(ctx, endpoint, opts)
+	})
+}
+
+// GetCoalesceStats returns how many requests were sent to the API and how many
+// shared the response of an identical request in flight, zero if coalescing is disabled.
+//
+// Example:
+//
+//	stats := client.GetCoalesceStats()
+//	fmt.Printf("upstream=%d deduplicated=%d\n", stats.Upstream, stats.Deduplicated)
+func (c *HTTPClient) GetCoalesceStats() CoalesceStats {
+	return c.flights.stats()
+}
@@ -0,0 +1,124 @@
+package birdeye
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newCoalescingClient creates a coalescing client against a server holding
+// requests until release is closed.
+func newCoalescingClient(t *testing.T) (*HTTPClient, *requestRecorder, chan struct{}) {
+	t.Helper()
+	release := make(chan struct{})
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		CoalesceRequests: true,
+		RetryPolicy:      &RetryPolicy{MaxAttempts: 1},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		okResponse(w, r)
+	})
+	// Registered after the server's cleanup, so that it runs first
+	t.Cleanup(func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	})
+	return client, rec, release
+}
+
+// waitFor polls cond until it holds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHTTPClientCoalescing(t *testing.T) {
+	client, rec, release := newCoalescingClient(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 11)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetTokenPrice(ctx, testTokenSOL, nil)
+			errs <- err
+		}()
+	}
+	waitFor(t, "deduplicated calls", func() bool { return client.GetCoalesceStats().Deduplicated == 9 })
+
+	// Opting out sends the call on its own
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := client.GetTokenPrice(WithoutCoalescing(ctx), testTokenSOL, nil)
+		errs <- err
+	}()
+	waitFor(t, "the opted out call", func() bool { return rec.count() == 2 })
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if stats := client.GetCoalesceStats(); stats.Upstream != 1 || stats.Deduplicated != 9 {
+		t.Fatalf("Expected 1 upstream and 9 deduplicated calls, got %+v", stats)
+	}
+
+	// Calls made after the response are sent again
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if rec.count() != 3 {
+		t.Fatalf("Expected 3 calls, got %d", rec.count())
+	}
+}
+
+func TestHTTPClientCoalescingCanceledLeader(t *testing.T) {
+	client, rec, release := newCoalescingClient(t)
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenPrice(leaderCtx, testTokenSOL, nil)
+		leaderErr <- err
+	}()
+	waitFor(t, "the first call", func() bool { return rec.count() == 1 })
+
+	followerErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+		followerErr <- err
+	}()
+	waitFor(t, "the second call to wait", func() bool { return client.GetCoalesceStats().Deduplicated == 1 })
+
+	// The follower is not failed by the leader's cancellation
+	cancel()
+	if err := <-leaderErr; err == nil {
+		t.Fatalf("Expected the leader to be canceled, got %v", err)
+	}
+	waitFor(t, "the retried call", func() bool { return rec.count() == 2 })
+	close(release)
+	if err := <-followerErr; err != nil {
+		t.Fatalf("Expected the follower to succeed, got %v", err)
+	}
+}
@@ -0,0 +1,397 @@
+package birdeye
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Compute unit (credit) accounting.
+//
+// Birdeye bills every call in compute units (CU). The cost depends on the
+// endpoint and, for batch endpoints, on the number of addresses queried.
+// ComputeUnitMeter prices each call, keeps running daily, monthly and
+// per-endpoint totals, and refuses calls that would exceed a budget.
+
+// ============================================================================
+// Cost Table
+// ============================================================================
+
+// EndpointCost is the compute unit price of an endpoint.
+type EndpointCost struct {
+	// Units is the cost of a single call, or of a single address for batch endpoints
+	Units int
+
+	// Batch marks endpoints whose cost scales with the number of addresses
+	// as Units * N^BatchExponent
+	Batch bool
+}
+
+// DefaultEndpointCosts holds the compute unit cost of each endpoint.
+//
+// The values follow Birdeye's compute unit documentation at the time of writing.
+// Pricing changes over time, verify them against your plan and override them
+// through ComputeUnitConfig.Costs if they differ.
+var DefaultEndpointCosts = map[string]EndpointCost{
+	// Price and market data
+	EndpointDefiPrice:                        {Units: 10},
+	EndpointDefiMultiPrice:                   {Units: 10, Batch: true},
+	EndpointDefiPriceVolumeSingle:            {Units: 15},
+	EndpointDefiPriceVolumeMulti:             {Units: 15, Batch: true},
+	EndpointDefiTokenOverview:                {Units: 30},
+	EndpointDefiV2TokensTopTraders:           {Units: 30},
+	EndpointDefiV3TokenMetadataSingle:        {Units: 5},
+	EndpointDefiV3TokenMetadataMultiple:      {Units: 5, Batch: true},
+	EndpointDefiV3TokenMarketData:            {Units: 15},
+	EndpointDefiV3TokenMarketDataMultiple:    {Units: 15, Batch: true},
+	EndpointDefiV3TokenTradeDataSingle:       {Units: 15},
+	EndpointDefiV3TokenTradeDataMultiple:     {Units: 15, Batch: true},
+	EndpointDefiV3AllTimeTradesSingle:        {Units: 15},
+	EndpointDefiV3AllTimeTradesMultiple:      {Units: 15, Batch: true},
+	EndpointDefiV3PairOverviewSingle:         {Units: 20},
+	EndpointDefiV3PairOverviewMultiple:       {Units: 20, Batch: true},
+	EndpointDefiV3PriceStatsSingle:           {Units: 20},
+	EndpointDefiV3PriceStatsMultiple:         {Units: 20, Batch: true},
+	EndpointDefiV3TokenExitLiquidity:         {Units: 30},
+	EndpointDefiV3TokenExitLiquidityMultiple: {Units: 30, Batch: true},
+
+	// Token lists and security
+	EndpointDefiTokenList:               {Units: 30},
+	EndpointDefiV3TokenList:             {Units: 100},
+	EndpointDefiV3TokenListScroll:       {Units: 500},
+	EndpointDefiTokenSecurity:           {Units: 50},
+	EndpointDefiTokenCreationInfo:       {Units: 80},
+	EndpointDefiTokenTrending:           {Units: 50},
+	EndpointDefiV2TokensNewListing:      {Units: 80},
+	EndpointDefiV2Markets:               {Units: 50},
+	EndpointDefiV3TokenHolder:           {Units: 50},
+	EndpointTokenV1HolderBatch:          {Units: 10, Batch: true},
+	EndpointDefiV3TokenMemeList:         {Units: 100},
+	EndpointDefiV3TokenMemeDetailSingle: {Units: 30},
+	EndpointDefiV3Search:                {Units: 50},
+
+	// Historical data and transactions
+	EndpointDefiHistoryPrice:           {Units: 60},
+	EndpointDefiHistoricalPriceUnix:    {Units: 10},
+	EndpointDefiOHLCV:                  {Units: 40},
+	EndpointDefiOHLCVPair:              {Units: 40},
+	EndpointDefiOHLCVBaseQuote:         {Units: 40},
+	EndpointDefiV3OHLCV:                {Units: 40},
+	EndpointDefiV3OHLCVPair:            {Units: 40},
+	EndpointDefiTxsToken:               {Units: 10},
+	EndpointDefiTxsPair:                {Units: 10},
+	EndpointDefiTxsTokenSeekByTime:     {Units: 15},
+	EndpointDefiTxsPairSeekByTime:      {Units: 15},
+	EndpointDefiV3TokenTxs:             {Units: 20},
+	EndpointDefiV3Txs:                  {Units: 20},
+	EndpointDefiV3TokenMintBurnTxs:     {Units: 20},
+	EndpointDefiV3TxsLatestBlock:       {Units: 5},
+	EndpointTraderGainersLosers:        {Units: 30},
+	EndpointTraderTxsSeekByTime:        {Units: 15},
+	EndpointDefiNetworks:               {Units: 1},
+	EndpointV1WalletListSupportedChain: {Units: 1},
+	EndpointUtilsV1Credits:             {Units: 1},
+
+	// Wallet
+	EndpointV1WalletTokenList:       {Units: 100},
+	EndpointV1WalletTxList:          {Units: 150},
+	EndpointV1WalletTokenBalance:    {Units: 5},
+	EndpointV2WalletCurrentNetWorth: {Units: 60},
+	EndpointV2WalletNetWorth:        {Units: 60},
+	EndpointV2WalletNetWorthDetails: {Units: 60},
+	EndpointV2WalletPnl:             {Units: 60},
+	EndpointV2WalletPnlMultiple:     {Units: 60, Batch: true},
+	EndpointV2WalletTokenBalance:    {Units: 5, Batch: true},
+	EndpointV2WalletTxFirstFunded:   {Units: 30},
+}
+
+// ============================================================================
+// Budget Errors
+// ============================================================================
+
+// ErrBudgetExceeded is the sentinel wrapped by BudgetExceededError.
+var ErrBudgetExceeded = errors.New("compute unit budget exceeded")
+
+// BudgetPeriod identifies the budget a call was refused by.
+type BudgetPeriod string
+
+const (
+	BudgetPeriodDaily   BudgetPeriod = "daily"
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+)
+
+// BudgetExceededError is returned when a call would exceed a compute unit budget.
+//
+// The call is not sent. It unwraps to ErrBudgetExceeded:
+//
+//	var budgetErr *birdeye.BudgetExceededError
+//	if errors.As(err, &budgetErr) {
+//	    log.Printf("%s budget reached: %d/%d CU", budgetErr.Period, budgetErr.Used, budgetErr.Budget)
+//	}
+type BudgetExceededError struct {
+	Endpoint string
+	Period   BudgetPeriod
+	Cost     int64 // Cost of the refused call
+	Used     int64 // Units already used in the period
+	Budget   int64 // Budget of the period
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("%s: %s compute unit budget exceeded: %d used + %d requested > %d",
+		e.Endpoint, e.Period, e.Used, e.Cost, e.Budget)
+}
+
+func (e *BudgetExceededError) Unwrap() error {
+	return ErrBudgetExceeded
+}
+
+// ============================================================================
+// ComputeUnitMeter
+// ============================================================================
+
+// ComputeUnitConfig configures a ComputeUnitMeter.
+type ComputeUnitConfig struct {
+	// Costs overrides or extends DefaultEndpointCosts
+	// Optional, default: nil
+	Costs map[string]EndpointCost
+
+	// DefaultUnits is the cost of endpoints missing from the cost table
+	// Optional, default: 10
+	DefaultUnits int
+
+	// BatchExponent scales batch costs as Units * N^BatchExponent
+	// Optional, default: 0.8
+	BatchExponent float64
+
+	// DailyBudget is the maximum number of units per day, 0 means unlimited
+	// Optional, default: 0
+	DailyBudget int64
+
+	// MonthlyBudget is the maximum number of units per month, 0 means unlimited
+	// Optional, default: 0
+	MonthlyBudget int64
+
+	// Location determines where days and months start
+	// Optional, default: time.UTC
+	Location *time.Location
+
+	// Clock is the time source deciding when days and months roll over
+	// Optional, default: nil (system clock)
+	Clock Clock
+}
+
+// EndpointUsage holds the usage of a single endpoint.
+type EndpointUsage struct {
+	Calls int64
+	Units int64
+}
+
+// ComputeUnitUsage is a snapshot of a ComputeUnitMeter.
+type ComputeUnitUsage struct {
+	Daily         int64     // Units used since Day
+	Monthly       int64     // Units used since Month
+	Total         int64     // Units used since the meter was created or reset
+	DailyBudget   int64     // 0 means unlimited
+	MonthlyBudget int64     // 0 means unlimited
+	Day           time.Time // Start of the current day
+	Month         time.Time // Start of the current month
+
+	// Endpoints holds the usage of each endpoint since the meter was created or reset
+	Endpoints map[string]EndpointUsage
+}
+
+// ComputeUnitMeter prices calls and tracks compute unit usage against budgets.
+//
+// A meter is safe for concurrent use and can be shared between clients
+// through HTTPClientConfig.ComputeUnitMeter to enforce a common budget.
+type ComputeUnitMeter struct {
+	config ComputeUnitConfig
+	costs  map[string]EndpointCost
+
+	mu        sync.Mutex
+	day       time.Time
+	month     time.Time
+	daily     int64
+	monthly   int64
+	total     int64
+	endpoints map[string]EndpointUsage
+}
+
+// NewComputeUnitMeter creates a compute unit meter.
+//
+// Example:
+//
+//	meter := birdeye.NewComputeUnitMeter(birdeye.ComputeUnitConfig{
+//	    DailyBudget:   2_000_000,
+//	    MonthlyBudget: 50_000_000,
+//	})
+//	client := birdeye.NewHTTPClient(birdeye.HTTPClientConfig{
+//	    APIKey:           "your-api-key",
+//	    ComputeUnitMeter: meter,
+//	})
+func NewComputeUnitMeter(config ComputeUnitConfig) *ComputeUnitMeter {
+	if config.DefaultUnits <= 0 {
+		config.DefaultUnits = 10
+	}
+	if config.BatchExponent <= 0 {
+		config.BatchExponent = 0.8
+	}
+	if config.Location == nil {
+		config.Location = time.UTC
+	}
+	config.Clock = clockOrReal(config.Clock)
+
+	costs := maps.Clone(DefaultEndpointCosts)
+	maps.Copy(costs, config.Costs)
+
+	m := &ComputeUnitMeter{
+		config:    config,
+		costs:     costs,
+		endpoints: make(map[string]EndpointUsage),
+	}
+	m.roll(m.config.Clock.Now())
+	return m
+}
+
+// Cost returns the compute units a call costs.
+//
+// Args:
+//   - endpoint: One of the Endpoint* constants
+//   - addresses: Number of addresses queried, only used by batch endpoints
+func (m *ComputeUnitMeter) Cost(endpoint string, addresses int) int64 {
+	cost, ok := m.costs[endpoint]
+	if !ok {
+		return int64(m.config.DefaultUnits)
+	}
+	if !cost.Batch || addresses <= 1 {
+		return int64(cost.Units)
+	}
+	return int64(math.Ceil(float64(cost.Units) * math.Pow(float64(addresses), m.config.BatchExponent)))
+}
+
+// ComputeUnitCharge is a call recorded by ComputeUnitMeter.Charge, which
+// Refund takes back.
+type ComputeUnitCharge struct {
+	Endpoint string
+	Units    int64
+	Day      time.Time // Start of the day the units were counted in
+	Month    time.Time // Start of the month the units were counted in
+}
+
+// Charge records a call and returns the charge, whose Units is the call's cost.
+//
+// It returns a *BudgetExceededError, without recording anything, if the call
+// would exceed the daily or monthly budget.
+func (m *ComputeUnitMeter) Charge(endpoint string, addresses int) (ComputeUnitCharge, error) {
+	cost := m.Cost(endpoint, addresses)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roll(m.config.Clock.Now())
+	if m.config.DailyBudget > 0 && m.daily+cost > m.config.DailyBudget {
+		return ComputeUnitCharge{}, &BudgetExceededError{Endpoint: endpoint, Period: BudgetPeriodDaily, Cost: cost, Used: m.daily, Budget: m.config.DailyBudget}
+	}
+	if m.config.MonthlyBudget > 0 && m.monthly+cost > m.config.MonthlyBudget {
+		return ComputeUnitCharge{}, &BudgetExceededError{Endpoint: endpoint, Period: BudgetPeriodMonthly, Cost: cost, Used: m.monthly, Budget: m.config.MonthlyBudget}
+	}
+
+	m.daily += cost
+	m.monthly += cost
+	m.total += cost
+	usage := m.endpoints[endpoint]
+	usage.Calls++
+	usage.Units += cost
+	m.endpoints[endpoint] = usage
+	return ComputeUnitCharge{Endpoint: endpoint, Units: cost, Day: m.day, Month: m.month}, nil
+}
+
+// Refund reverts a charge for a call that was not billed, e.g. because it failed
+// before reaching the API.
+//
+// The daily and monthly counters are only reverted if the charge was counted
+// in the current day and month; a period that has rolled over is left alone.
+func (m *ComputeUnitMeter) Refund(charge ComputeUnitCharge) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	units := charge.Units
+	m.roll(m.config.Clock.Now())
+	if charge.Day.Equal(m.day) {
+		m.daily = max(0, m.daily-units)
+	}
+	if charge.Month.Equal(m.month) {
+		m.monthly = max(0, m.monthly-units)
+	}
+	m.total = max(0, m.total-units)
+	if usage, ok := m.endpoints[charge.Endpoint]; ok {
+		usage.Calls = max(0, usage.Calls-1)
+		usage.Units = max(0, usage.Units-units)
+		m.endpoints[charge.Endpoint] = usage
+	}
+}
+
+// Usage returns a snapshot of the current usage.
+func (m *ComputeUnitMeter) Usage() ComputeUnitUsage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.roll(m.config.Clock.Now())
+	return ComputeUnitUsage{
+		Daily:         m.daily,
+		Monthly:       m.monthly,
+		Total:         m.total,
+		DailyBudget:   m.config.DailyBudget,
+		MonthlyBudget: m.config.MonthlyBudget,
+		Day:           m.day,
+		Month:         m.month,
+		Endpoints:     maps.Clone(m.endpoints),
+	}
+}
+
+// Reset clears all usage counters.
+func (m *ComputeUnitMeter) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.daily, m.monthly, m.total = 0, 0, 0
+	m.endpoints = make(map[string]EndpointUsage)
+}
+
+// roll resets the daily and monthly counters when a new period starts.
+// The caller must hold the lock.
+func (m *ComputeUnitMeter) roll(now time.Time) {
+	now = now.In(m.config.Location)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, m.config.Location)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, m.config.Location)
+	if !day.Equal(m.day) {
+		m.day = day
+		m.daily = 0
+	}
+	if !month.Equal(m.month) {
+		m.month = month
+		m.monthly = 0
+	}
+}
+
+// countAddresses returns the number of addresses in the request parameters of
+// a batch endpoint: the longest list parameter, or the entries of a
+// comma-separated list_address.
+func countAddresses(params map[string]any) int {
+	n := 1
+	for key, v := range params {
+		switch v := v.(type) {
+		case []string:
+			n = max(n, len(v))
+		case string:
+			if key == "list_address" && v != "" {
+				n = max(n, strings.Count(v, ",")+1)
+			}
+		}
+	}
+	return n
+}
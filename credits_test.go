@@ -0,0 +1,132 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestComputeUnitCost(t *testing.T) {
+	meter := NewComputeUnitMeter(ComputeUnitConfig{
+		Costs: map[string]EndpointCost{EndpointDefiPrice: {Units: 7}},
+	})
+
+	if cost := meter.Cost(EndpointDefiPrice, 1); cost != 7 {
+		t.Errorf("Expected overridden cost 7, got %d", cost)
+	}
+	if cost := meter.Cost("/unknown", 1); cost != 10 {
+		t.Errorf("Expected default cost 10, got %d", cost)
+	}
+	// 10 * 100^0.8 = 398.1
+	if cost := meter.Cost(EndpointDefiMultiPrice, 100); cost != 399 {
+		t.Errorf("Expected batch cost 399, got %d", cost)
+	}
+	if cost := meter.Cost(EndpointDefiOHLCV, 100); cost != 40 {
+		t.Errorf("Expected non-batch cost 40, got %d", cost)
+	}
+}
+
+func TestComputeUnitBudget(t *testing.T) {
+	meter := NewComputeUnitMeter(ComputeUnitConfig{DailyBudget: 25, MonthlyBudget: 1000})
+
+	var charge ComputeUnitCharge
+	for range 2 {
+		var err error
+		if charge, err = meter.Charge(EndpointDefiPrice, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := meter.Charge(EndpointDefiPrice, 1)
+	var budgetErr *BudgetExceededError
+	if !errors.As(err, &budgetErr) || !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("Expected BudgetExceededError, got %v", err)
+	}
+	if budgetErr.Period != BudgetPeriodDaily || budgetErr.Used != 20 || budgetErr.Cost != 10 {
+		t.Errorf("Unexpected error: %+v", budgetErr)
+	}
+
+	meter.Refund(charge)
+	usage := meter.Usage()
+	if usage.Daily != 10 || usage.Monthly != 10 || usage.Endpoints[EndpointDefiPrice] != (EndpointUsage{Calls: 1, Units: 10}) {
+		t.Errorf("Unexpected usage after refund: %+v", usage)
+	}
+
+	// A new day resets the daily counter only
+	meter.day = meter.day.Add(-24 * time.Hour)
+	meter.roll(time.Now())
+	if usage := meter.Usage(); usage.Daily != 0 || usage.Monthly != 10 {
+		t.Errorf("Unexpected usage after day rollover: %+v", usage)
+	}
+}
+
+func TestComputeUnitRefundAfterRollover(t *testing.T) {
+	clock := NewManualClock(time.Date(2024, 1, 31, 23, 59, 0, 0, time.UTC))
+	meter := NewComputeUnitMeter(ComputeUnitConfig{Clock: clock})
+
+	charge, err := meter.Charge(EndpointDefiPrice, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(time.Minute)
+	if _, err := meter.Charge(EndpointDefiPrice, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// The refund of yesterday's charge leaves the new day and month alone
+	meter.Refund(charge)
+	usage := meter.Usage()
+	if usage.Daily != 10 || usage.Monthly != 10 || usage.Total != 10 {
+		t.Errorf("Unexpected usage after refund across rollover: %+v", usage)
+	}
+}
+
+func TestCountAddresses(t *testing.T) {
+	if n := countAddresses(map[string]any{"list_address": []string{"a", "b", "c"}}); n != 3 {
+		t.Errorf("Expected 3, got %d", n)
+	}
+	if n := countAddresses(map[string]any{"list_address": "a,b"}); n != 2 {
+		t.Errorf("Expected 2, got %d", n)
+	}
+	if n := countAddresses(map[string]any{"address": "a"}); n != 1 {
+		t.Errorf("Expected 1, got %d", n)
+	}
+}
+
+func TestHTTPClientComputeUnits(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == EndpointDefiPrice {
+			w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"success":false,"message":"bad request"}`))
+	}))
+	defer server.Close()
+
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:           "test-key",
+		BaseURL:          server.URL,
+		ComputeUnitMeter: NewComputeUnitMeter(ComputeUnitConfig{DailyBudget: 15}),
+	})
+	ctx := context.Background()
+
+	// Failed calls are refunded
+	if _, err := client.GetMultiTokenPrice(ctx, []string{testTokenSOL}, nil); err == nil || errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("Expected API error, got %v", err)
+	}
+
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("Expected ErrBudgetExceeded, got %v", err)
+	}
+
+	usage := client.GetComputeUnitUsage()
+	if usage.Daily != 10 || usage.Endpoints[EndpointDefiPrice].Calls != 1 || usage.Endpoints[EndpointDefiMultiPrice].Units != 0 {
+		t.Errorf("Unexpected usage: %+v", usage)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"reflect"
 	"strings"
 	"time"
 )
@@ -23,7 +25,7 @@ import (
 // - Support for multiple blockchain networks
 // - Comprehensive error handling and retry logic
 //
-// Rate Limiting:
+// Rate Limiting (Business plan defaults, see RateLimitProfile for other plans):
 // - 300 RPS: Price and market data endpoints
 // - 150 RPS: Token list and security endpoints
 // - 100 RPS: Historical data and transaction endpoints
@@ -99,6 +101,7 @@ const (
 	EndpointDefiV3TxsLatestBlock             = "/defi/v3/txs/latest-block"
 	EndpointV1WalletListSupportedChain       = "/v1/wallet/list_supported_chain"
 	EndpointDefiV3TokenListScroll            = "/defi/v3/token/list/scroll"
+	EndpointUtilsV1Credits                   = "/utils/v1/credits"
 )
 
 // ============================================================================
@@ -106,17 +109,48 @@ const (
 // ============================================================================
 
 // BirdeyeAPIError is the base error type for all Birdeye API errors
+//
+// It unwraps to the sentinel error matching its status code, so callers can
+// branch on the error class:
+//
+//	if errors.Is(err, birdeye.ErrTooManyRequests) {
+//	    // back off
+//	}
 type BirdeyeAPIError struct {
 	Message    string
 	StatusCode int
 	Response   map[string]any
+
+	// Endpoint is the API path that was requested (e.g. "/defi/price")
+	Endpoint string
+	// Params holds the request query parameters or body, with credentials redacted
+	Params map[string]any
+	// Header holds the response headers
+	Header http.Header
+	// RequestID is the request identifier returned by the server, if any
+	RequestID string
 }
 
 func (e *BirdeyeAPIError) Error() string {
+	msg := e.Message
+	if e.Endpoint != "" {
+		msg = e.Endpoint + ": " + msg
+	}
 	if e.StatusCode > 0 {
-		return fmt.Sprintf("[%d] %s", e.StatusCode, e.Message)
+		return fmt.Sprintf("[%d] %s", e.StatusCode, msg)
 	}
-	return e.Message
+	return msg
+}
+
+// Unwrap returns the sentinel error matching the status code, or nil if there is none.
+func (e *BirdeyeAPIError) Unwrap() error {
+	return statusSentinel(e.StatusCode)
+}
+
+// Is reports whether target is a *BirdeyeAPIError with the same status code.
+func (e *BirdeyeAPIError) Is(target error) bool {
+	t, ok := target.(*BirdeyeAPIError)
+	return ok && t.StatusCode == e.StatusCode
 }
 
 // Specific error types
@@ -132,6 +166,60 @@ var (
 	ErrTimeout             = errors.New("request timeout")
 )
 
+// statusSentinel maps an HTTP status code to its sentinel error
+func statusSentinel(statusCode int) error {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrBadRequest
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return ErrTimeout
+	case http.StatusUnprocessableEntity:
+		return ErrUnprocessableEntity
+	case http.StatusTooManyRequests:
+		return ErrTooManyRequests
+	}
+	if statusCode >= 500 {
+		return ErrInternalServer
+	}
+	return nil
+}
+
+// requestIDHeaders are the response headers that may carry a request identifier
+var requestIDHeaders = []string{"X-Request-Id", "X-Amzn-Requestid", "X-Amz-Cf-Id", "Cf-Ray"}
+
+// requestIDFromHeader returns the first request identifier found in the headers
+func requestIDFromHeader(header http.Header) string {
+	for _, name := range requestIDHeaders {
+		if id := header.Get(name); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// redactParams returns a copy of params with credential-like values masked
+func redactParams(params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+	redacted := make(map[string]any, len(params))
+	for k, v := range params {
+		switch strings.ToLower(strings.ReplaceAll(k, "-", "_")) {
+		case "api_key", "apikey", "x_api_key", "key", "token", "secret":
+			redacted[k] = "REDACTED"
+		default:
+			redacted[k] = v
+		}
+	}
+	return redacted
+}
+
 // ============================================================================
 // HTTPClient Structure
 // ============================================================================
@@ -173,17 +261,20 @@ var (
 //	}
 //	fmt.Printf("SOL Price: $%.2f\n", price.Value)
 type HTTPClient struct {
-	apiKey           string
-	baseURL          string
-	chains           []Chain
-	httpClient       *http.Client
-	limiter300RPS    *RateLimiter
-	limiter150RPS    *RateLimiter
-	limiter100RPS    *RateLimiter
-	limiterWallet    *MultiRateLimiter
-	limiter2RPS      *RateLimiter
-	endpointLimiters map[string]any
-	onLimitExceeded  RateLimitBehavior
+	keys            *keyPool
+	baseURL         string
+	chains          []Chain
+	httpClient      *http.Client
+	onLimitExceeded RateLimitBehavior
+	retryPolicy     RetryPolicy
+	meter           *ComputeUnitMeter
+	weights         map[string]EndpointWeight
+	cache           *responseCache
+	flights         *flightGroup
+	batcher         *microBatcher
+	rangeWorkers    int
+	windows         map[string]int64
+	clock           Clock
 }
 
 // HTTPClientConfig holds configuration for creating a new HTTPClient.
@@ -210,6 +301,253 @@ type HTTPClientConfig struct {
 	// Options: RateLimitBlock (wait), RateLimitRaise (return error).
 	// Optional, default: RateLimitBlock
 	OnLimitExceeded RateLimitBehavior
+
+	// RetryPolicy controls retries of network errors and retryable status codes
+	// (429 and 5xx by default), including backoff, jitter and Retry-After handling.
+	// If nil, DefaultRetryPolicy() is used. Set MaxAttempts to 1 to disable retries.
+	// Optional, default: nil (DefaultRetryPolicy)
+	RetryPolicy *RetryPolicy
+
+	// CategoryLimiters replaces the built-in limiter shared by all endpoints of a category,
+	// e.g. with a limiter shared across services. Categories not present keep their default limiter.
+	// Optional, default: nil (built-in limiters)
+	CategoryLimiters map[EndpointCategory]Limiter
+
+	// EndpointLimiters assigns a dedicated limiter to individual endpoints, keyed by the
+	// Endpoint* constants. Takes precedence over CategoryLimiters.
+	// Optional, default: nil (category limiters)
+	EndpointLimiters map[string]Limiter
+
+	// RateLimitProfile selects the rate limits of the built-in category limiters.
+	// Use one of the RateLimitProfile* presets matching your Birdeye plan, or a
+	// custom profile.
+	// Optional, default: &RateLimitProfileBusiness
+	RateLimitProfile *RateLimitProfile
+
+	// AdaptiveRateLimit enables adaptive rate limiting. The built-in category
+	// limiters are wrapped in AdaptiveLimiter, which shrinks their limits on 429
+	// responses and recovers them gradually.
+	// Custom limiters receive the same feedback if they implement ResponseObserver.
+	// Optional, default: nil (static limits)
+	AdaptiveRateLimit *AdaptiveConfig
+
+	// ComputeUnitMeter prices every call in compute units and enforces its budgets.
+	// Share one meter between clients to enforce a common budget.
+	// Optional, default: a meter with DefaultEndpointCosts and no budgets
+	ComputeUnitMeter *ComputeUnitMeter
+
+	// Clock is the time source of the built-in rate limiters, the default
+	// compute unit meter and retry backoff. Pass a ManualClock to test code
+	// built on the client deterministically, without real sleeps.
+	// Optional, default: nil (system clock)
+	Clock Clock
+
+	// SharedLimiterBackend stores the buckets of the built-in category limiters,
+	// so that clients in several processes using one API key share its quota,
+	// e.g. a FileLimiterBackend for workers on one host. The limits still come
+	// from RateLimitProfile, enforced as token buckets.
+	// Optional, default: nil (in-memory buckets per client)
+	SharedLimiterBackend SharedLimiterBackend
+
+	// SharedLimiterKey prefixes the keys of the shared buckets. Clients with the
+	// same prefix share their quota.
+	// Optional, default: derived from APIKey
+	SharedLimiterKey string
+
+	// APIKeys spreads requests over several API keys, e.g. one per project.
+	// Each key gets its own built-in limiters and optional budget, while
+	// CategoryLimiters and EndpointLimiters are shared by all keys. APIKey is
+	// ignored when set. See GetAPIKeyStatus for the health of each key.
+	// Optional, default: nil (APIKey only)
+	APIKeys []APIKeyConfig
+
+	// KeySelection chooses the key of each request when APIKeys is set.
+	// Optional, default: KeySelectionLeastLoaded
+	KeySelection KeySelection
+
+	// KeyBenchDuration is how long a key of APIKeys is left out after a 401,
+	// 402 or 403 response or after exhausting its budget.
+	// Optional, default: DefaultKeyBenchDuration (1m)
+	KeyBenchDuration time.Duration
+
+	// EndpointWeights overrides or extends DefaultEndpointWeights, the number
+	// of rate limit tokens a call takes per endpoint. Weights are capped at the
+	// smallest limit of the endpoint's limiter.
+	// Optional, default: nil (DefaultEndpointWeights)
+	EndpointWeights map[string]EndpointWeight
+
+	// Cache enables the response cache. Successful responses of the endpoints
+	// with a TTL are served from it without spending quota. Use
+	// WithCacheBypass to skip it for a call.
+	// Optional, default: nil (no caching)
+	Cache *CacheConfig
+
+	// CoalesceRequests collapses identical concurrent requests into one API
+	// call whose response they share. Use WithoutCoalescing to opt out for a call.
+	// Optional, default: false
+	CoalesceRequests bool
+
+	// Batching collects concurrent GetTokenPrice, GetTokenMetadata,
+	// GetTokenMarketData and GetTokenTradeData calls into requests to the
+	// matching multiple-address endpoints.
+	// Optional, default: nil (no batching)
+	Batching *BatchConfig
+
+	// OHLCVRangeConcurrency is the number of chunks the OHLCV range fetchers
+	// (GetTokenOHLCVRange, ...) request at once. Requests still go through the
+	// rate limiters.
+	// Optional, default: DefaultOHLCVRangeConcurrency
+	OHLCVRangeConcurrency int
+
+	// PaginationWindows sets the largest offset + limit the pagination
+	// iterators and walkers request per endpoint, for endpoints accepting less
+	// than MaxPaginationWindow.
+	// Optional, default: nil (MaxPaginationWindow for every endpoint)
+	PaginationWindows map[string]int64
+}
+
+// EndpointCategory identifies a group of endpoints that share one rate limiter.
+type EndpointCategory string
+
+const (
+	// EndpointCategoryMarketData covers price and market data endpoints (300 RPS)
+	EndpointCategoryMarketData EndpointCategory = "market_data"
+	// EndpointCategoryTokenList covers token list and security endpoints (150 RPS)
+	EndpointCategoryTokenList EndpointCategory = "token_list"
+	// EndpointCategoryHistorical covers historical data and transaction endpoints (100 RPS).
+	// Endpoints without an explicit category fall back to it.
+	EndpointCategoryHistorical EndpointCategory = "historical"
+	// EndpointCategoryWallet covers wallet endpoints (30 RPS / 150 RPM)
+	EndpointCategoryWallet EndpointCategory = "wallet"
+	// EndpointCategoryScroll covers scroll endpoints (2 RPS)
+	EndpointCategoryScroll EndpointCategory = "scroll"
+)
+
+// endpointCategories lists all endpoint categories
+var endpointCategories = []EndpointCategory{
+	EndpointCategoryMarketData,
+	EndpointCategoryTokenList,
+	EndpointCategoryHistorical,
+	EndpointCategoryWallet,
+	EndpointCategoryScroll,
+}
+
+// ============================================================================
+// Rate Limit Profiles
+// ============================================================================
+
+// RateLimitProfile maps endpoint categories to the rate limits enforced for them.
+//
+// A category with several limits is enforced by a MultiRateLimiter, all limits
+// must be satisfied. Each limit uses the algorithm selected by RateLimit.Algorithm;
+// the presets enforce per-minute wallet quotas with a sliding window so a burst
+// never exceeds the server's view of the quota.
+//
+// Categories missing from Categories use the limits of
+// EndpointCategoryHistorical, or those of RateLimitProfileBusiness if the
+// profile does not define it either.
+//
+// Example:
+//
+//	profile := birdeye.RateLimitProfile{
+//	    Name: "custom",
+//	    Categories: map[birdeye.EndpointCategory][]birdeye.RateLimit{
+//	        birdeye.EndpointCategoryHistorical: {{Limit: 20, Period: time.Second}},
+//	        birdeye.EndpointCategoryWallet:     {{Limit: 5, Period: time.Second}, {Limit: 60, Period: time.Minute}},
+//	    },
+//	}
+//	client := birdeye.NewHTTPClient(birdeye.HTTPClientConfig{
+//	    APIKey:           "your-api-key",
+//	    RateLimitProfile: &profile,
+//	})
+type RateLimitProfile struct {
+	// Name identifies the profile, e.g. the Birdeye plan it matches
+	Name string
+
+	// Categories holds the rate limits of each endpoint category
+	Categories map[EndpointCategory][]RateLimit
+}
+
+// Rate limit presets for Birdeye plans.
+//
+// The numbers follow Birdeye's published per-plan limits. Verify them against
+// your account and use a custom RateLimitProfile if they differ.
+var (
+	// RateLimitProfileStandard matches the Standard (free) plan: 1 RPS on every endpoint
+	RateLimitProfileStandard = RateLimitProfile{
+		Name: "standard",
+		Categories: map[EndpointCategory][]RateLimit{
+			EndpointCategoryMarketData: {{Limit: 1, Period: time.Second}},
+			EndpointCategoryTokenList:  {{Limit: 1, Period: time.Second}},
+			EndpointCategoryHistorical: {{Limit: 1, Period: time.Second}},
+			EndpointCategoryWallet:     {{Limit: 1, Period: time.Second}},
+			EndpointCategoryScroll:     {{Limit: 1, Period: time.Second}},
+		},
+	}
+
+	// RateLimitProfileStarter matches the Starter plan: 15 RPS
+	RateLimitProfileStarter = RateLimitProfile{
+		Name: "starter",
+		Categories: map[EndpointCategory][]RateLimit{
+			EndpointCategoryMarketData: {{Limit: 15, Period: time.Second}},
+			EndpointCategoryTokenList:  {{Limit: 15, Period: time.Second}},
+			EndpointCategoryHistorical: {{Limit: 15, Period: time.Second}},
+			EndpointCategoryWallet:     {{Limit: 5, Period: time.Second}, {Limit: 75, Period: time.Minute, Algorithm: RateLimitSlidingWindow}},
+			EndpointCategoryScroll:     {{Limit: 1, Period: time.Second}},
+		},
+	}
+
+	// RateLimitProfilePremium matches the Premium plan: 50 RPS
+	RateLimitProfilePremium = RateLimitProfile{
+		Name: "premium",
+		Categories: map[EndpointCategory][]RateLimit{
+			EndpointCategoryMarketData: {{Limit: 50, Period: time.Second}},
+			EndpointCategoryTokenList:  {{Limit: 50, Period: time.Second}},
+			EndpointCategoryHistorical: {{Limit: 50, Period: time.Second}},
+			EndpointCategoryWallet:     {{Limit: 15, Period: time.Second}, {Limit: 100, Period: time.Minute, Algorithm: RateLimitSlidingWindow}},
+			EndpointCategoryScroll:     {{Limit: 2, Period: time.Second}},
+		},
+	}
+
+	// RateLimitProfileBusiness matches the Business plan and is the default:
+	// 300 RPS market data, 150 RPS token list, 100 RPS historical,
+	// 30 RPS / 150 RPM wallet and 2 RPS scroll endpoints
+	RateLimitProfileBusiness = RateLimitProfile{
+		Name: "business",
+		Categories: map[EndpointCategory][]RateLimit{
+			EndpointCategoryMarketData: {{Limit: 300, Period: time.Second}},
+			EndpointCategoryTokenList:  {{Limit: 150, Period: time.Second}},
+			EndpointCategoryHistorical: {{Limit: 100, Period: time.Second}},
+			EndpointCategoryWallet:     {{Limit: 30, Period: time.Second}, {Limit: 150, Period: time.Minute, Algorithm: RateLimitSlidingWindow}},
+			EndpointCategoryScroll:     {{Limit: 2, Period: time.Second}},
+		},
+	}
+)
+
+// limitsFor returns the rate limits of a category, applying the profile fallbacks
+func (p *RateLimitProfile) limitsFor(category EndpointCategory) []RateLimit {
+	if limits := p.Categories[category]; len(limits) > 0 {
+		return limits
+	}
+	if limits := p.Categories[EndpointCategoryHistorical]; len(limits) > 0 {
+		return limits
+	}
+	return RateLimitProfileBusiness.Categories[category]
+}
+
+// newLimiter creates the limiter of a category
+func (p *RateLimitProfile) newLimiter(category EndpointCategory, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) Limiter {
+	limits := p.limitsFor(category)
+	if len(limits) == 1 {
+		if limiter, err := newTier(limits[0], onLimitExceeded, opts...); err == nil {
+			return limiter
+		}
+	} else if limiter, err := NewMultiRateLimiter(limits, onLimitExceeded, opts...); err == nil {
+		return limiter
+	}
+
+	// Invalid limits in a custom profile fall back to the default profile
+	return RateLimitProfileBusiness.newLimiter(category, onLimitExceeded, opts...)
 }
 
 // NewHTTPClient creates a new Birdeye API client with automatic rate limiting.
@@ -225,7 +563,8 @@ type HTTPClientConfig struct {
 //   - error: Error if configuration is invalid (e.g., missing API key)
 //
 // Rate Limiters:
-// The client automatically creates and manages rate limiters for different endpoint categories:
+// The client automatically creates and manages rate limiters for different endpoint categories.
+// The limits come from config.RateLimitProfile, by default RateLimitProfileBusiness:
 //   - 300 RPS: Price and market data endpoints
 //   - 150 RPS: Token list and security endpoints
 //   - 100 RPS: Historical data and transaction endpoints
@@ -268,43 +607,106 @@ func NewHTTPClient(config HTTPClientConfig) *HTTPClient {
 		config.OnLimitExceeded = RateLimitBlock
 	}
 
-	// Create rate limiters
-	limiter300, _ := NewRateLimiter(300, time.Second, config.OnLimitExceeded)
-	limiter150, _ := NewRateLimiter(150, time.Second, config.OnLimitExceeded)
-	limiter100, _ := NewRateLimiter(100, time.Second, config.OnLimitExceeded)
-	limiter2, _ := NewRateLimiter(2, time.Second, config.OnLimitExceeded)
+	retryPolicy := DefaultRetryPolicy()
+	if config.RetryPolicy != nil {
+		retryPolicy = *config.RetryPolicy
+	}
+	retryPolicy = retryPolicy.withDefaults()
 
-	limiterWallet, _ := NewMultiRateLimiter(
-		[]RateLimit{
-			{Limit: 30, Period: time.Second},
-			{Limit: 150, Period: time.Minute},
-		},
-		config.OnLimitExceeded,
-	)
+	if config.RateLimitProfile == nil {
+		config.RateLimitProfile = &RateLimitProfileBusiness
+	}
 
 	client := &HTTPClient{
-		apiKey:           config.APIKey,
-		baseURL:          strings.TrimRight(config.BaseURL, "/"),
-		chains:           config.Chains,
-		httpClient:       config.HTTPClient,
-		limiter300RPS:    limiter300,
-		limiter150RPS:    limiter150,
-		limiter100RPS:    limiter100,
-		limiterWallet:    limiterWallet,
-		limiter2RPS:      limiter2,
-		onLimitExceeded:  config.OnLimitExceeded,
-		endpointLimiters: make(map[string]any),
-	}
-
-	// Map endpoints to their rate limiters
-	client.initEndpointLimiters()
+		baseURL:         strings.TrimRight(config.BaseURL, "/"),
+		chains:          config.Chains,
+		httpClient:      config.HTTPClient,
+		onLimitExceeded: config.OnLimitExceeded,
+		retryPolicy:     retryPolicy,
+		meter:           config.ComputeUnitMeter,
+		weights:         maps.Clone(DefaultEndpointWeights),
+		rangeWorkers:    config.OHLCVRangeConcurrency,
+		windows:         maps.Clone(config.PaginationWindows),
+		clock:           clockOrReal(config.Clock),
+	}
+	if client.rangeWorkers <= 0 {
+		client.rangeWorkers = DefaultOHLCVRangeConcurrency
+	}
+	maps.Copy(client.weights, config.EndpointWeights)
+	client.cache = newResponseCache(config.Cache, client.clock)
+	client.flights = newFlightGroup(config.CoalesceRequests)
+	client.batcher = newMicroBatcher(client, config.Batching)
+	if client.meter == nil {
+		client.meter = NewComputeUnitMeter(ComputeUnitConfig{Clock: client.clock})
+	}
+
+	// Create the API keys with their rate limiters
+	client.initAPIKeys(config)
 
 	return client
 }
 
-// initEndpointLimiters initializes the endpoint to limiter mapping
-func (c *HTTPClient) initEndpointLimiters() {
-	// 300 RPS endpoints
+// initAPIKeys creates the key pool, with the limiters of each key
+func (c *HTTPClient) initAPIKeys(config HTTPClientConfig) {
+	c.keys = &keyPool{selection: config.KeySelection, clock: c.clock}
+	if len(config.APIKeys) == 0 {
+		// A single key is never benched
+		key := newAPIKey(APIKeyConfig{Key: config.APIKey})
+		c.initEndpointLimiters(key, config)
+		c.keys.keys = []*apiKey{key}
+		return
+	}
+
+	c.keys.benchFor = config.KeyBenchDuration
+	if c.keys.benchFor <= 0 {
+		c.keys.benchFor = DefaultKeyBenchDuration
+	}
+	for _, keyConfig := range config.APIKeys {
+		key := newAPIKey(keyConfig)
+		keyLimits := config
+		keyLimits.APIKey = keyConfig.Key
+		if keyConfig.RateLimitProfile != nil {
+			keyLimits.RateLimitProfile = keyConfig.RateLimitProfile
+		}
+		if config.SharedLimiterKey != "" {
+			keyLimits.SharedLimiterKey = fmt.Sprintf("%s-%x", config.SharedLimiterKey, bucketHash(keyConfig.Key))
+		}
+		c.initEndpointLimiters(key, keyLimits)
+		c.keys.keys = append(c.keys.keys, key)
+	}
+}
+
+// initEndpointLimiters creates the category limiters of a key from the rate limit
+// profile and initializes its endpoint to limiter mapping
+func (c *HTTPClient) initEndpointLimiters(k *apiKey, config HTTPClientConfig) {
+	if config.SharedLimiterBackend != nil && config.SharedLimiterKey == "" {
+		config.SharedLimiterKey = fmt.Sprintf("birdeye-%x", bucketHash(config.APIKey))
+	}
+
+	for _, category := range endpointCategories {
+		var limiter Limiter = config.RateLimitProfile.newLimiter(category, config.OnLimitExceeded, WithClock(c.clock))
+		if config.SharedLimiterBackend != nil {
+			key := config.SharedLimiterKey + ":" + string(category)
+			if shared, err := NewBackendLimiter(config.SharedLimiterBackend, key, config.RateLimitProfile.limitsFor(category), config.OnLimitExceeded, WithClock(c.clock)); err == nil {
+				limiter = shared
+			}
+		}
+		if config.AdaptiveRateLimit != nil {
+			if adaptive, err := NewAdaptiveLimiter(limiter, *config.AdaptiveRateLimit); err == nil {
+				limiter = adaptive
+			}
+		}
+		k.categoryLimiters[category] = limiter
+	}
+
+	// Apply user supplied category limiters
+	for category, limiter := range config.CategoryLimiters {
+		if limiter != nil {
+			k.categoryLimiters[category] = limiter
+		}
+	}
+
+	// Market data endpoints
 	endpoints300 := []string{
 		EndpointDefiPrice, EndpointDefiMultiPrice, EndpointDefiOHLCVBaseQuote,
 		EndpointDefiPriceVolumeSingle, EndpointDefiPriceVolumeMulti,
@@ -315,18 +717,18 @@ func (c *HTTPClient) initEndpointLimiters() {
 		EndpointDefiV3AllTimeTradesSingle, EndpointDefiV3AllTimeTradesMultiple,
 	}
 	for _, ep := range endpoints300 {
-		c.endpointLimiters[ep] = c.limiter300RPS
+		k.endpointLimiters[ep] = k.categoryLimiters[EndpointCategoryMarketData]
 	}
 
-	// 150 RPS endpoints
+	// Token list and security endpoints
 	endpoints150 := []string{
 		EndpointDefiTokenList, EndpointDefiTokenSecurity,
 	}
 	for _, ep := range endpoints150 {
-		c.endpointLimiters[ep] = c.limiter150RPS
+		k.endpointLimiters[ep] = k.categoryLimiters[EndpointCategoryTokenList]
 	}
 
-	// 100 RPS endpoints
+	// Historical data and transaction endpoints
 	endpoints100 := []string{
 		EndpointDefiHistoryPrice, EndpointDefiHistoricalPriceUnix,
 		EndpointDefiTxsToken, EndpointDefiTxsPair,
@@ -343,13 +745,13 @@ func (c *HTTPClient) initEndpointLimiters() {
 		EndpointDefiV3OHLCVPair, EndpointDefiV3PriceStatsSingle,
 		EndpointDefiV3PriceStatsMultiple, EndpointDefiV3TokenExitLiquidity,
 		EndpointDefiV3TokenExitLiquidityMultiple, EndpointDefiV3TokenMemeList,
-		EndpointDefiV3TokenMemeDetailSingle,
+		EndpointDefiV3TokenMemeDetailSingle, EndpointUtilsV1Credits,
 	}
 	for _, ep := range endpoints100 {
-		c.endpointLimiters[ep] = c.limiter100RPS
+		k.endpointLimiters[ep] = k.categoryLimiters[EndpointCategoryHistorical]
 	}
 
-	// Wallet endpoints (multi-tier: 30 RPS / 150 RPM)
+	// Wallet endpoints (multi-tier)
 	endpointsWallet := []string{
 		EndpointV1WalletTokenList, EndpointV1WalletTxList,
 		EndpointV1WalletTokenBalance, EndpointV1WalletListSupportedChain,
@@ -359,32 +761,41 @@ func (c *HTTPClient) initEndpointLimiters() {
 		EndpointV2WalletTxFirstFunded,
 	}
 	for _, ep := range endpointsWallet {
-		c.endpointLimiters[ep] = c.limiterWallet
+		k.endpointLimiters[ep] = k.categoryLimiters[EndpointCategoryWallet]
 	}
 
-	// 2 RPS endpoint (scroll)
-	c.endpointLimiters[EndpointDefiV3TokenListScroll] = c.limiter2RPS
-}
+	// Scroll endpoint
+	k.endpointLimiters[EndpointDefiV3TokenListScroll] = k.categoryLimiters[EndpointCategoryScroll]
 
-// getLimiter gets the appropriate rate limiter for an endpoint
-func (c *HTTPClient) getLimiter(endpoint string) any {
-	if limiter, ok := c.endpointLimiters[endpoint]; ok {
-		return limiter
+	// User supplied per-endpoint limiters
+	for ep, limiter := range config.EndpointLimiters {
+		if limiter != nil {
+			k.endpointLimiters[ep] = limiter
+		}
 	}
-	return c.limiter100RPS
+}
+
+// GetLimiterStatus returns the status of the rate limiter assigned to an endpoint.
+//
+// With several APIKeys, it reports the limiter of the first key.
+//
+// Example:
+//
+//	for _, s := range client.GetLimiterStatus(birdeye.EndpointV1WalletTokenList) {
+//	    fmt.Printf("%d/%v: %.1f tokens available\n", s.Limit, s.Period, s.AvailableTokens)
+//	}
+func (c *HTTPClient) GetLimiterStatus(endpoint string) []LimiterStatus {
+	return c.keys.primary().getLimiter(endpoint).GetStatus()
 }
 
 // getHeaders builds the HTTP headers for API requests
-func (c *HTTPClient) getHeaders(chains []Chain) http.Header {
+func (c *HTTPClient) getHeaders(apiKey string, chains []Chain) http.Header {
 	headers := http.Header{
 		"Accept":    []string{"application/json"},
-		"X-API-KEY": []string{c.apiKey},
+		"X-API-KEY": []string{apiKey},
 	}
 
-	chainsToUse := chains
-	if chainsToUse == nil && c.chains != nil {
-		chainsToUse = c.chains
-	}
+	chainsToUse := c.requestChains(requestOptions{chains: chains})
 
 	if len(chainsToUse) > 0 {
 		chainStrs := make([]string, len(chainsToUse))
@@ -403,46 +814,72 @@ type requestOptions struct {
 	chains          []Chain
 	onLimitExceeded RateLimitBehavior
 	paramsUseArray  bool `default:"false"`
-	respJustItems   bool `default:"false"`
 	paramsOrBody    map[string]any
 }
 
-// request makes a rate-limited request to the Birdeye API
-func (c *HTTPClient) request(ctx context.Context, endpoint string, opts requestOptions) (map[string]any, error) {
-	// Get rate limiter
-	limiter := c.getLimiter(endpoint)
-
-	// Acquire rate limit token
-	behavior := c.onLimitExceeded
-	if opts.onLimitExceeded != "" {
-		behavior = opts.onLimitExceeded
-	}
-
-	var acquired bool
+// do makes a rate-limited request to the Birdeye API and returns the raw response body.
+//
+// A nil body with a nil error means the request was skipped by the rate limiter.
+func (c *HTTPClient) do(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
+	var body []byte
 	var err error
-
-	switch v := limiter.(type) {
-	case *RateLimiter:
-		acquired, err = v.Acquire(ctx, 1, &opts.onLimitExceeded)
-	case *MultiRateLimiter:
-		acquired, err = v.Acquire(ctx, 1, &opts.onLimitExceeded)
-	default:
-		return nil, errors.New("invalid limiter type")
+	// Serve cacheable requests through the response cache
+	if c.cache.ttl(endpoint) > 0 {
+		body, err = c.doCached(ctx, endpoint, opts)
+	} else {
+		body, err = c.fetchShared(ctx, endpoint, opts)
 	}
+	if body == nil && err == nil {
+		reportSkipped(ctx)
+	}
+	return body, err
+}
 
+// fetch charges compute units for a request and sends it to the API.
+func (c *HTTPClient) fetch(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
+	// Charge compute units, refusing calls over budget
+	charge, err := c.meter.Charge(endpoint, countAddresses(opts.paramsOrBody))
 	if err != nil {
 		return nil, err
 	}
 
-	if !acquired {
-		if behavior == RateLimitSkip {
-			return nil, nil
-		}
-		return nil, ErrRateLimitExceeded
+	body, err := c.send(ctx, endpoint, opts)
+	if body == nil {
+		// Calls that never got a successful response are not billed
+		c.meter.Refund(charge)
+	}
+	return body, err
+}
+
+// requestChains returns the chains sent with a request
+func (c *HTTPClient) requestChains(opts requestOptions) []Chain {
+	if opts.chains == nil {
+		return c.chains
+	}
+	return opts.chains
+}
+
+// GetCacheStats returns the hits and misses of the response cache, zero if caching is disabled.
+func (c *HTTPClient) GetCacheStats() CacheStats {
+	return c.cache.stats()
+}
+
+// errNotAcquired reports an attempt refused by the rate limiter
+var errNotAcquired = errors.New("rate limit token not acquired")
+
+// send acquires rate limit tokens and sends the request, retrying transient failures.
+// Every attempt picks an API key; keys rejected by the API are benched and the
+// attempt is retried with another key.
+func (c *HTTPClient) send(ctx context.Context, endpoint string, opts requestOptions) ([]byte, error) {
+	behavior := c.onLimitExceeded
+	if opts.onLimitExceeded != "" {
+		behavior = opts.onLimitExceeded
 	}
 
 	// Build URL
 	reqURL := c.baseURL + endpoint
+	addresses := countAddresses(opts.paramsOrBody)
+	tokens := weightFor(c.weights, endpoint, opts.paramsOrBody)
 
 	// Process parameters
 	if opts.paramsOrBody != nil && !opts.paramsUseArray {
@@ -453,117 +890,361 @@ func (c *HTTPClient) request(ctx context.Context, endpoint string, opts requestO
 		}
 	}
 
-	// Retry logic for network errors
-	var resp *http.Response
-	maxRetries := 3
+	// Retry logic for network errors and retryable status codes
+	policy := c.retryPolicy
+	var lastErr error
 
-	for attempt := range maxRetries {
-		var req *http.Request
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		key, charge, err := c.keys.pick(endpoint, addresses)
+		if err != nil {
+			return nil, errors.Join(err, lastErr)
+		}
 
-		if opts.method == "POST" {
-			// POST request with JSON body
-			bodyData, _ := json.Marshal(opts.paramsOrBody)
-			req, err = http.NewRequestWithContext(ctx, "POST", reqURL, bytes.NewReader(bodyData))
-			if err != nil {
-				return nil, err
+		resp, bodyBytes, err := c.sendWithKey(ctx, key, endpoint, reqURL, opts, tokens, behavior)
+		key.inFlight.Add(-1)
+		if err == nil && resp.StatusCode == 200 {
+			key.record(nil)
+			return bodyBytes, nil
+		}
+		key.refund(charge)
+
+		switch {
+		case errors.Is(err, errNotAcquired):
+			// Every retry is a new call against the endpoint's quota
+			if attempt > 1 {
+				return nil, lastErr
 			}
-		} else {
-			// GET request
-			req, err = http.NewRequestWithContext(ctx, "GET", reqURL, nil)
-			if err != nil {
-				return nil, err
+			if behavior == RateLimitSkip {
+				return nil, nil
 			}
-
-			// Add query parameters
-			if opts.paramsOrBody != nil {
-				q := req.URL.Query()
-				for k, v := range opts.paramsOrBody {
-					q.Add(k, fmt.Sprintf("%v", v))
+			return nil, ErrRateLimitExceeded
+
+		case errors.Is(err, ErrNetwork):
+			key.record(err)
+			lastErr = err
+			if attempt < policy.MaxAttempts {
+				if err := sleepContext(ctx, c.clock, policy.backoff(attempt)); err != nil {
+					return nil, err
 				}
-				req.URL.RawQuery = q.Encode()
 			}
+			continue
+
+		case err != nil:
+			return nil, err
 		}
 
-		req.Header = c.getHeaders(opts.chains)
-		if opts.method == "POST" {
-			req.Header.Set("Content-Type", "application/json")
+		err = newAPIError(endpoint, resp, bodyBytes, opts)
+		key.record(err)
+		if c.keys.benchOnStatus(key, resp.StatusCode, err) && attempt < policy.MaxAttempts {
+			// Another key may be accepted, retry without waiting
+			lastErr = err
+			continue
+		}
+		if !policy.isRetryableStatus(resp.StatusCode) || attempt == policy.MaxAttempts {
+			return nil, err
+		}
+
+		// Honour Retry-After unless it exceeds what the policy tolerates
+		wait, ok := policy.retryDelay(attempt, resp.Header, c.clock.Now())
+		if !ok {
+			return nil, err
+		}
+		lastErr = err
+		if err := sleepContext(ctx, c.clock, wait); err != nil {
+			return nil, err
 		}
+	}
+
+	return nil, lastErr
+}
+
+// sendWithKey acquires rate limit tokens of the key and sends a single attempt with it.
+// It returns errNotAcquired if the limiter refused the tokens and wraps network
+// errors in ErrNetwork.
+func (c *HTTPClient) sendWithKey(ctx context.Context, key *apiKey, endpoint, reqURL string, opts requestOptions, tokens int, behavior RateLimitBehavior) (*http.Response, []byte, error) {
+	acquired, err := key.acquire(ctx, endpoint, tokens, behavior)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !acquired {
+		return nil, nil, errNotAcquired
+	}
+
+	req, err := c.newRequest(ctx, key.key, reqURL, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Make request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNetwork, err)
+	}
+	key.observeResponse(endpoint, resp)
+	bodyBytes, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNetwork, err)
+	}
+	return resp, bodyBytes, nil
+}
+
+// GetComputeUnitUsage returns the compute units used by the client, in total and per endpoint.
+//
+// Example:
+//
+//	usage := client.GetComputeUnitUsage()
+//	fmt.Printf("today: %d CU, this month: %d CU\n", usage.Daily, usage.Monthly)
+//	for endpoint, u := range usage.Endpoints {
+//	    fmt.Printf("%s: %d calls, %d CU\n", endpoint, u.Calls, u.Units)
+//	}
+func (c *HTTPClient) GetComputeUnitUsage() ComputeUnitUsage {
+	return c.meter.Usage()
+}
+
+// newRequest builds the HTTP request for a single attempt
+func (c *HTTPClient) newRequest(ctx context.Context, apiKey, reqURL string, opts requestOptions) (*http.Request, error) {
+	var req *http.Request
+	var err error
 
-		// Make request
-		resp, err = c.httpClient.Do(req)
+	if opts.method == "POST" {
+		// POST request with JSON body
+		bodyData, _ := json.Marshal(opts.paramsOrBody)
+		req, err = http.NewRequestWithContext(ctx, "POST", reqURL, bytes.NewReader(bodyData))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// GET request
+		req, err = http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 		if err != nil {
-			if attempt < maxRetries-1 {
-				time.Sleep(time.Duration(attempt) * time.Second)
-				continue
+			return nil, err
+		}
+
+		// Add query parameters
+		if opts.paramsOrBody != nil {
+			q := req.URL.Query()
+			for k, v := range opts.paramsOrBody {
+				q.Add(k, fmt.Sprintf("%v", v))
 			}
-			return nil, fmt.Errorf("%w: %v", ErrNetwork, err)
+			req.URL.RawQuery = q.Encode()
 		}
-		break
 	}
 
-	defer resp.Body.Close()
+	req.Header = c.getHeaders(apiKey, opts.chains)
+	if opts.method == "POST" {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
-	// Read response body
-	bodyBytes, err := io.ReadAll(resp.Body)
+	return req, nil
+}
+
+// newAPIError builds the error for a non-200 response; gateways may answer with non-JSON bodies
+func newAPIError(endpoint string, resp *http.Response, bodyBytes []byte, opts requestOptions) *BirdeyeAPIError {
+	var result map[string]any
+	_ = json.Unmarshal(bodyBytes, &result)
+
+	message, _ := result["message"].(string)
+	if message == "" {
+		message = string(bodyBytes)
+	}
+	return &BirdeyeAPIError{
+		Message:    message,
+		StatusCode: resp.StatusCode,
+		Response:   result,
+		Endpoint:   endpoint,
+		Params:     redactParams(opts.paramsOrBody),
+		Header:     resp.Header,
+		RequestID:  requestIDFromHeader(resp.Header),
+	}
+}
+
+// ============================================================================
+// Typed Response Decoding
+// ============================================================================
+
+// apiEnvelope is the top-level shape of a Birdeye response.
+// Fields are kept raw so that data is decoded only once, straight into its target type.
+type apiEnvelope struct {
+	Data       json.RawMessage `json:"data"`
+	Pagination json.RawMessage `json:"pagination"`
+}
+
+// requestData makes a request and decodes the response data into T
+func requestData[T any](ctx context.Context, c *HTTPClient, endpoint string, opts requestOptions) (T, error) {
+	body, err := c.do(ctx, endpoint, opts)
+	if err != nil || body == nil {
+		var zero T
+		return zero, err
+	}
+	reportResponseMeta(ctx, body)
+	return decodeData[T](body)
+}
+
+// requestItems makes a request and decodes the response items list into T
+func requestItems[T any](ctx context.Context, c *HTTPClient, endpoint string, opts requestOptions) (T, error) {
+	body, err := c.do(ctx, endpoint, opts)
+	if err != nil || body == nil {
+		var zero T
+		return zero, err
+	}
+	reportResponseMeta(ctx, body)
+	return decodeItems[T](body)
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a context recording into meta the metadata of the
+// response of a call. Use it with methods returning a slice, which have no
+// field to hold it, or their ...WithMeta variants, which return it in a
+// RespPage; meta.Pagination is nil if the response has none.
+//
+// Example:
+//
+//	var meta birdeye.ResponseMeta
+//	holders, err := client.GetTokenHolders(birdeye.WithResponseMeta(ctx, &meta), address, nil)
+//	if err == nil && meta.Pagination != nil {
+//	    log.Printf("%d of %d holders", len(holders), meta.Pagination.Total)
+//	}
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+type skipReportKey struct{}
+
+// withSkipReport returns a context recording into skipped whether a request
+// made with it was skipped by the rate limiter. The getters return an empty
+// response on a skip; the iterators use the report to tell it from the end
+// of the data.
+func withSkipReport(ctx context.Context, skipped *bool) context.Context {
+	return context.WithValue(ctx, skipReportKey{}, skipped)
+}
+
+// reportSkipped records a skipped request into the context's skip report, if any.
+func reportSkipped(ctx context.Context) {
+	if skipped, ok := ctx.Value(skipReportKey{}).(*bool); ok && skipped != nil {
+		*skipped = true
+	}
+}
+
+// withResponseMeta makes a call returning a slice and wraps its items with
+// the metadata of the response.
+func withResponseMeta[T any](ctx context.Context, call func(context.Context) ([]T, error)) (*RespPage[T], error) {
+	var meta ResponseMeta
+	items, err := call(WithResponseMeta(ctx, &meta))
 	if err != nil {
 		return nil, err
 	}
+	return &RespPage[T]{Items: items, ResponseMeta: meta}, nil
+}
 
-	// Parse JSON response
-	var result map[string]any
-	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		// If not valid JSON, return error
-		return nil, fmt.Errorf("invalid JSON response: %w", err)
+// reportResponseMeta records the metadata of a response body into the
+// context's ResponseMeta, if any. The pagination object is read from the
+// envelope, or from data when the envelope has none.
+func reportResponseMeta(ctx context.Context, body []byte) {
+	dst, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta)
+	if !ok || dst == nil {
+		return
 	}
+	*dst = ResponseMeta{}
 
-	// Handle non-200 status codes
-	if resp.StatusCode != 200 {
-		message, _ := result["message"].(string)
-		if message == "" {
-			message = string(bodyBytes)
+	var env apiEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return
+	}
+	raw := env.Pagination
+	if raw == nil && isJSONObject(env.Data) {
+		var data struct {
+			Pagination json.RawMessage `json:"pagination"`
 		}
-		return nil, &BirdeyeAPIError{
-			Message:    message,
-			StatusCode: resp.StatusCode,
-			Response:   result,
+		if err := json.Unmarshal(env.Data, &data); err == nil {
+			raw = data.Pagination
 		}
 	}
+	if !isJSONObject(raw) {
+		return
+	}
+	var pagination Pagination
+	if err := json.Unmarshal(raw, &pagination); err == nil {
+		dst.Pagination = &pagination
+	}
+}
+
+// decodeData decodes the data field of a response envelope into T.
+//
+// If the envelope has no data field, the whole body is decoded. A top-level
+// pagination object is merged into T when T is a struct with a pagination field.
+func decodeData[T any](body []byte) (T, error) {
+	var out T
 
-	// Extract data field
-	data, hasData := result["data"]
-	if !hasData {
-		return result, nil
+	var env apiEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return out, fmt.Errorf("invalid JSON response: %w", err)
 	}
 
-	// Handle pagination
-	if pagination, ok := result["pagination"].(map[string]any); ok {
-		if dataMap, ok := data.(map[string]any); ok {
-			dataMap["pagination"] = pagination
-			data = dataMap
-		} else if dataArr, ok := data.([]any); ok {
-			data = map[string]any{
-				"items":      dataArr,
-				"pagination": pagination,
-			}
+	if env.Data == nil {
+		if err := json.Unmarshal(body, &out); err != nil {
+			return out, err
 		}
+		return out, nil
 	}
 
-	// Return just items if requested
-	if opts.respJustItems {
-		if dataMap, ok := data.(map[string]any); ok {
-			if items, ok := dataMap["items"]; ok {
-				return map[string]any{"items": items}, nil
-			}
+	if err := json.Unmarshal(env.Data, &out); err != nil {
+		return out, err
+	}
+
+	if env.Pagination != nil && isJSONObject(env.Data) && reflect.TypeFor[T]().Kind() == reflect.Struct {
+		wrapped := make([]byte, 0, len(env.Pagination)+len(`{"pagination":}`))
+		wrapped = append(wrapped, `{"pagination":`...)
+		wrapped = append(wrapped, env.Pagination...)
+		wrapped = append(wrapped, '}')
+		if err := json.Unmarshal(wrapped, &out); err != nil {
+			return out, err
 		}
 	}
 
-	// Return data as map
-	if dataMap, ok := data.(map[string]any); ok {
-		return dataMap, nil
+	return out, nil
+}
+
+// decodeItems decodes the items list of a response envelope into T.
+//
+// The list is read from data.items, or from data itself when data is an array.
+func decodeItems[T any](body []byte) (T, error) {
+	var out T
+
+	var env apiEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return out, fmt.Errorf("invalid JSON response: %w", err)
+	}
+
+	if isJSONArray(env.Data) {
+		err := json.Unmarshal(env.Data, &out)
+		return out, err
 	}
 
-	// If data is not a map, wrap it
-	return map[string]any{"data": data}, nil
+	if env.Data == nil {
+		return out, nil
+	}
+
+	var items struct {
+		Items T `json:"items"`
+	}
+	if err := json.Unmarshal(env.Data, &items); err != nil {
+		return out, err
+	}
+	return items.Items, nil
+}
+
+// isJSONObject reports whether raw holds a JSON object
+func isJSONObject(raw json.RawMessage) bool {
+	raw = bytes.TrimLeft(raw, " \t\r\n")
+	return len(raw) > 0 && raw[0] == '{'
+}
+
+// isJSONArray reports whether raw holds a JSON array
+func isJSONArray(raw json.RawMessage) bool {
+	raw = bytes.TrimLeft(raw, " \t\r\n")
+	return len(raw) > 0 && raw[0] == '['
 }
 
 // ============================================================================
@@ -603,24 +1284,9 @@ func (c *HTTPClient) request(ctx context.Context, endpoint string, opts requestO
 //	}
 //	// Output: solana, ethereum, arbitrum, avalanche, bsc, optimism, polygon, base, zksync, sui
 func (c *HTTPClient) GetSupportedNetworks(ctx context.Context) ([]Chain, error) {
-	result, err := c.request(ctx, EndpointDefiNetworks, requestOptions{
+	return requestData[[]Chain](ctx, c, EndpointDefiNetworks, requestOptions{
 		method: "GET",
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Parse chains from result
-	var chains []Chain
-	if data, ok := result["data"].([]any); ok {
-		for _, item := range data {
-			if chain, ok := item.(string); ok {
-				chains = append(chains, Chain(chain))
-			}
-		}
-	}
-
-	return chains, nil
 }
 
 // GetWalletSupportedNetworks retrieves the list of blockchain networks supported for wallet operations.
@@ -650,24 +1316,9 @@ func (c *HTTPClient) GetSupportedNetworks(ctx context.Context) ([]Chain, error)
 //	fmt.Printf("Wallet networks: %v\n", walletNetworks)
 //	// Output: [solana]
 func (c *HTTPClient) GetWalletSupportedNetworks(ctx context.Context) ([]Chain, error) {
-	result, err := c.request(ctx, EndpointV1WalletListSupportedChain, requestOptions{
+	return requestData[[]Chain](ctx, c, EndpointV1WalletListSupportedChain, requestOptions{
 		method: "GET",
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Parse chains from result
-	var chains []Chain
-	if data, ok := result["data"].([]any); ok {
-		for _, item := range data {
-			if chain, ok := item.(string); ok {
-				chains = append(chains, Chain(chain))
-			}
-		}
-	}
-
-	return chains, nil
 }
 
 // ============================================================================
@@ -772,10 +1423,7 @@ func (c *HTTPClient) GetTokenPrice(ctx context.Context, address string, opts *To
 		return nil, fmt.Errorf("failed to apply defaults: %w", err)
 	}
 
-	// Add required parameters
-	params["address"] = address
-
-	result, err := c.request(ctx, EndpointDefiPrice, requestOptions{
+	price, err := requestBatched[RespTokenPrice](ctx, c, EndpointDefiPrice, EndpointDefiMultiPrice, address, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -785,13 +1433,6 @@ func (c *HTTPClient) GetTokenPrice(ctx context.Context, address string, opts *To
 		return nil, err
 	}
 
-	// Parse result into RespTokenPrice
-	var price RespTokenPrice
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &price); err != nil {
-		return nil, err
-	}
-
 	return &price, nil
 }
 
@@ -905,24 +1546,12 @@ func (c *HTTPClient) GetMultiTokenPrice(ctx context.Context, addresses []string,
 		params["include_liquidity"] = "true"
 	}
 
-	result, err := c.request(ctx, EndpointDefiMultiPrice, requestOptions{
+	return requestData[map[string]RespTokenPrice](ctx, c, EndpointDefiMultiPrice, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	// Parse result into map[string]RespTokenPrice
-	var prices map[string]RespTokenPrice
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &prices); err != nil {
-		return nil, err
-	}
-
-	return prices, nil
 }
 
 // ============================================================================
@@ -1042,7 +1671,7 @@ func (c *HTTPClient) GetTokenTxs(ctx context.Context, address string, opts *Toke
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiTxsToken, requestOptions{
+	txs, err := requestData[RespTokenTxs](ctx, c, EndpointDefiTxsToken, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -1052,12 +1681,6 @@ func (c *HTTPClient) GetTokenTxs(ctx context.Context, address string, opts *Toke
 		return nil, err
 	}
 
-	var txs RespTokenTxs
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -1159,7 +1782,7 @@ func (c *HTTPClient) GetTokenOHLCV(ctx context.Context, address string, interval
 	params["time_from"] = timeFrom
 	params["time_to"] = timeTo
 
-	result, err := c.request(ctx, EndpointDefiOHLCV, requestOptions{
+	ohlcv, err := requestData[RespTokenOHLCVs](ctx, c, EndpointDefiOHLCV, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -1169,12 +1792,6 @@ func (c *HTTPClient) GetTokenOHLCV(ctx context.Context, address string, interval
 		return nil, err
 	}
 
-	var ohlcv RespTokenOHLCVs
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &ohlcv); err != nil {
-		return nil, err
-	}
-
 	return &ohlcv, nil
 }
 
@@ -1220,26 +1837,16 @@ func (c *HTTPClient) GetTokenMetadata(ctx context.Context, address string, opts
 	if opts == nil {
 		opts = &TokenMetadataOptions{}
 	}
-	params := map[string]any{
-		"address": address,
-	}
-
-	result, err := c.request(ctx, EndpointDefiV3TokenMetadataSingle, requestOptions{
+	metadata, err := requestBatched[RespTokenMetadata](ctx, c, EndpointDefiV3TokenMetadataSingle, EndpointDefiV3TokenMetadataMultiple, address, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
-		paramsOrBody:    params,
+		paramsOrBody:    map[string]any{},
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	var metadata RespTokenMetadata
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &metadata); err != nil {
-		return nil, err
-	}
-
 	return &metadata, nil
 }
 
@@ -1285,23 +1892,12 @@ func (c *HTTPClient) GetMultiTokenMetadata(ctx context.Context, addresses []stri
 		"list_address": addresses,
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenMetadataMultiple, requestOptions{
+	return requestData[RespMultiTokenMetadata](ctx, c, EndpointDefiV3TokenMetadataMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var metadata RespMultiTokenMetadata
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &metadata); err != nil {
-		return nil, err
-	}
-
-	return metadata, nil
 }
 
 // ============================================================================
@@ -1370,10 +1966,7 @@ func (c *HTTPClient) GetTokenMarketData(ctx context.Context, address string, opt
 		return nil, fmt.Errorf("failed to apply defaults: %w", err)
 	}
 
-	// Add required parameters
-	params["address"] = address
-
-	result, err := c.request(ctx, EndpointDefiV3TokenMarketData, requestOptions{
+	marketData, err := requestBatched[RespTokenMarketData](ctx, c, EndpointDefiV3TokenMarketData, EndpointDefiV3TokenMarketDataMultiple, address, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -1383,12 +1976,6 @@ func (c *HTTPClient) GetTokenMarketData(ctx context.Context, address string, opt
 		return nil, err
 	}
 
-	var marketData RespTokenMarketData
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &marketData); err != nil {
-		return nil, err
-	}
-
 	return &marketData, nil
 }
 
@@ -1430,23 +2017,12 @@ func (c *HTTPClient) GetMultiTokenMarketData(ctx context.Context, addresses []st
 	// Add required parameters
 	params["list_address"] = addresses
 
-	result, err := c.request(ctx, EndpointDefiV3TokenMarketDataMultiple, requestOptions{
+	return requestData[map[string]RespTokenMarketData](ctx, c, EndpointDefiV3TokenMarketDataMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var marketData map[string]RespTokenMarketData
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &marketData); err != nil {
-		return nil, err
-	}
-
-	return marketData, nil
 }
 
 // ============================================================================
@@ -1510,14 +2086,11 @@ func (c *HTTPClient) GetTokenTradeData(ctx context.Context, address string, opts
 		return nil, fmt.Errorf("failed to apply defaults: %w", err)
 	}
 
-	// Add required parameters
-	params["address"] = address
-
 	if len(opts.Frames) > 0 {
 		params["frames"] = opts.Frames
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenTradeDataSingle, requestOptions{
+	tradeData, err := requestBatched[RespTokenTradeData](ctx, c, EndpointDefiV3TokenTradeDataSingle, EndpointDefiV3TokenTradeDataMultiple, address, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -1527,12 +2100,6 @@ func (c *HTTPClient) GetTokenTradeData(ctx context.Context, address string, opts
 		return nil, err
 	}
 
-	var tradeData RespTokenTradeData
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &tradeData); err != nil {
-		return nil, err
-	}
-
 	return &tradeData, nil
 }
 
@@ -1574,23 +2141,12 @@ func (c *HTTPClient) GetMultiTokenTradeData(ctx context.Context, addresses []str
 		params["frames"] = opts.Frames
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenTradeDataMultiple, requestOptions{
+	return requestData[map[string]RespTokenTradeData](ctx, c, EndpointDefiV3TokenTradeDataMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var tradeData map[string]RespTokenTradeData
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &tradeData); err != nil {
-		return nil, err
-	}
-
-	return tradeData, nil
 }
 
 // ============================================================================
@@ -1658,7 +2214,7 @@ func (c *HTTPClient) GetTokenSecurity(ctx context.Context, address string, opts
 		"address": address,
 	}
 
-	result, err := c.request(ctx, EndpointDefiTokenSecurity, requestOptions{
+	security, err := requestData[RespTokenSecurity](ctx, c, EndpointDefiTokenSecurity, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -1668,12 +2224,6 @@ func (c *HTTPClient) GetTokenSecurity(ctx context.Context, address string, opts
 		return nil, err
 	}
 
-	var security RespTokenSecurity
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &security); err != nil {
-		return nil, err
-	}
-
 	return &security, nil
 }
 
@@ -1715,6 +2265,8 @@ type TokenHoldersOptions struct {
 // This method retrieves information about token holders including holder addresses, balances,
 // and percentage of total supply.
 //
+// The pagination metadata of the response is returned by GetTokenHoldersWithMeta.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - address: Token contract address to query
@@ -1766,23 +2318,20 @@ func (c *HTTPClient) GetTokenHolders(ctx context.Context, address string, opts *
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiV3TokenHolder, requestOptions{
+	return requestItems[RespMultiTokenHolders](ctx, c, EndpointDefiV3TokenHolder, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var holders map[string]RespMultiTokenHolders
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &holders); err != nil {
-		return nil, err
-	}
+}
 
-	return holders["items"], nil
+// GetTokenHoldersWithMeta retrieves the holders of a token like
+// GetTokenHolders, together with the pagination metadata of the response.
+func (c *HTTPClient) GetTokenHoldersWithMeta(ctx context.Context, address string, opts *TokenHoldersOptions) (*RespPage[RespTokenHoldersItem], error) {
+	return withResponseMeta(ctx, func(ctx context.Context) ([]RespTokenHoldersItem, error) {
+		return c.GetTokenHolders(ctx, address, opts)
+	})
 }
 
 // ============================================================================
@@ -1868,24 +2417,12 @@ func (c *HTTPClient) GetWalletPortfolio(ctx context.Context, wallet string, opts
 	// Add required parameters
 	params["wallet"] = wallet
 
-	result, err := c.request(ctx, EndpointV1WalletTokenList, requestOptions{
+	return requestItems[RespWalletPortfolio](ctx, c, EndpointV1WalletTokenList, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var portfolio map[string]RespWalletPortfolio
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &portfolio); err != nil {
-		return nil, err
-	}
-
-	return portfolio["items"], nil
 }
 
 // ============================================================================
@@ -1980,23 +2517,12 @@ func (c *HTTPClient) GetWalletTxs(ctx context.Context, wallet string, opts *Wall
 	// Add required parameters
 	params["wallet"] = wallet
 
-	result, err := c.request(ctx, EndpointV1WalletTxList, requestOptions{
+	return requestData[map[Chain][]RespWalletTx](ctx, c, EndpointV1WalletTxList, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var txs map[Chain][]RespWalletTx
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
-	return txs, nil
 }
 
 // ============================================================================
@@ -2079,7 +2605,7 @@ func (c *HTTPClient) GetWalletNetWorth(ctx context.Context, wallet string, opts
 		params["filter_value"] = opts.FilterValue
 	}
 
-	result, err := c.request(ctx, EndpointV2WalletCurrentNetWorth, requestOptions{
+	netWorth, err := requestData[RespWalletNetWorth](ctx, c, EndpointV2WalletCurrentNetWorth, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2089,12 +2615,6 @@ func (c *HTTPClient) GetWalletNetWorth(ctx context.Context, wallet string, opts
 		return nil, err
 	}
 
-	var netWorth RespWalletNetWorth
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &netWorth); err != nil {
-		return nil, err
-	}
-
 	return &netWorth, nil
 }
 
@@ -2189,24 +2709,12 @@ func (c *HTTPClient) Search(ctx context.Context, keyword string, opts *SearchOpt
 		chains = []Chain{*opts.Chain}
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3Search, requestOptions{
+	return requestItems[RespSearchItems](ctx, c, EndpointDefiV3Search, requestOptions{
 		method:          "GET",
 		chains:          chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var searchResults map[string]RespSearchItems
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &searchResults); err != nil {
-		return nil, err
-	}
-
-	return searchResults["items"], nil
 }
 
 // ============================================================================
@@ -2292,7 +2800,7 @@ func (c *HTTPClient) GetPairTxs(ctx context.Context, address string, opts *PairT
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiTxsPair, requestOptions{
+	txs, err := requestData[RespPairTxs](ctx, c, EndpointDefiTxsPair, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2302,12 +2810,6 @@ func (c *HTTPClient) GetPairTxs(ctx context.Context, address string, opts *PairT
 		return nil, err
 	}
 
-	var txs RespPairTxs
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -2438,7 +2940,7 @@ func (c *HTTPClient) GetTokenTxsByTime(ctx context.Context, address string, opts
 		params["before_time"] = opts.BeforeTime
 	}
 
-	result, err := c.request(ctx, EndpointDefiTxsTokenSeekByTime, requestOptions{
+	txs, err := requestData[RespTokenTxsByTime](ctx, c, EndpointDefiTxsTokenSeekByTime, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2448,12 +2950,6 @@ func (c *HTTPClient) GetTokenTxsByTime(ctx context.Context, address string, opts
 		return nil, err
 	}
 
-	var txs RespTokenTxsByTime
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -2545,7 +3041,7 @@ func (c *HTTPClient) GetPairTxsByTime(ctx context.Context, address string, opts
 		params["before_time"] = opts.BeforeTime
 	}
 
-	result, err := c.request(ctx, EndpointDefiTxsPairSeekByTime, requestOptions{
+	txs, err := requestData[RespPairTxsByTime](ctx, c, EndpointDefiTxsPairSeekByTime, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2555,12 +3051,6 @@ func (c *HTTPClient) GetPairTxsByTime(ctx context.Context, address string, opts
 		return nil, err
 	}
 
-	var txs RespPairTxsByTime
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -2691,7 +3181,7 @@ func (c *HTTPClient) GetTokenTxsV3(ctx context.Context, address string, opts *To
 		params["after_block_number"] = opts.AfterBlockNumber
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenTxs, requestOptions{
+	txs, err := requestData[RespTokenTxsV3](ctx, c, EndpointDefiV3TokenTxs, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2701,12 +3191,6 @@ func (c *HTTPClient) GetTokenTxsV3(ctx context.Context, address string, opts *To
 		return nil, err
 	}
 
-	var txs RespTokenTxsV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -2798,24 +3282,12 @@ func (c *HTTPClient) GetPairOHLCV(ctx context.Context, address, intervalType str
 	params["time_from"] = timeFrom
 	params["time_to"] = timeTo
 
-	result, err := c.request(ctx, EndpointDefiOHLCVPair, requestOptions{
+	return requestItems[[]RespPairOHLCVItem](ctx, c, EndpointDefiOHLCVPair, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var ohlcv map[string][]RespPairOHLCVItem
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &ohlcv); err != nil {
-		return nil, err
-	}
-
-	return ohlcv["items"], nil
 }
 
 // ============================================================================
@@ -2896,7 +3368,7 @@ func (c *HTTPClient) GetPairOverview(ctx context.Context, address string, opts *
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiV3PairOverviewSingle, requestOptions{
+	overview, err := requestData[RespPairOverview](ctx, c, EndpointDefiV3PairOverviewSingle, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -2906,12 +3378,6 @@ func (c *HTTPClient) GetPairOverview(ctx context.Context, address string, opts *
 		return nil, err
 	}
 
-	var overview RespPairOverview
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &overview); err != nil {
-		return nil, err
-	}
-
 	return &overview, nil
 }
 
@@ -2965,23 +3431,12 @@ func (c *HTTPClient) GetPairsOverview(ctx context.Context, addresses []string, o
 	// Add required parameters
 	params["list_address"] = addresses
 
-	result, err := c.request(ctx, EndpointDefiV3PairOverviewMultiple, requestOptions{
+	return requestData[map[string]RespPairOverview](ctx, c, EndpointDefiV3PairOverviewMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var overviews map[string]RespPairOverview
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &overviews); err != nil {
-		return nil, err
-	}
-
-	return overviews, nil
 }
 
 // ============================================================================
@@ -3086,7 +3541,7 @@ func (c *HTTPClient) GetTokenListV3(ctx context.Context, opts *TokenListV3Option
 		params["max_fdv"] = opts.MaxFDV
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenList, requestOptions{
+	tokenList, err := requestData[RespTokenListV3](ctx, c, EndpointDefiV3TokenList, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3096,12 +3551,6 @@ func (c *HTTPClient) GetTokenListV3(ctx context.Context, opts *TokenListV3Option
 		return nil, err
 	}
 
-	var tokenList RespTokenListV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &tokenList); err != nil {
-		return nil, err
-	}
-
 	return &tokenList, nil
 }
 
@@ -3178,7 +3627,7 @@ func (c *HTTPClient) GetTokenOverview(ctx context.Context, address string, opts
 		params["frames"] = opts.Frames
 	}
 
-	result, err := c.request(ctx, EndpointDefiTokenOverview, requestOptions{
+	overview, err := requestData[RespTokenOverview](ctx, c, EndpointDefiTokenOverview, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3188,12 +3637,6 @@ func (c *HTTPClient) GetTokenOverview(ctx context.Context, address string, opts
 		return nil, err
 	}
 
-	var overview RespTokenOverview
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &overview); err != nil {
-		return nil, err
-	}
-
 	return &overview, nil
 }
 
@@ -3259,7 +3702,7 @@ func (c *HTTPClient) GetTokenCreationInfo(ctx context.Context, address string, o
 		"address": address,
 	}
 
-	result, err := c.request(ctx, EndpointDefiTokenCreationInfo, requestOptions{
+	info, err := requestData[RespTokenCreationInfo](ctx, c, EndpointDefiTokenCreationInfo, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3269,12 +3712,6 @@ func (c *HTTPClient) GetTokenCreationInfo(ctx context.Context, address string, o
 		return nil, err
 	}
 
-	var info RespTokenCreationInfo
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &info); err != nil {
-		return nil, err
-	}
-
 	return &info, nil
 }
 
@@ -3362,7 +3799,7 @@ func (c *HTTPClient) GetTokenTrendingList(ctx context.Context, opts *TrendingLis
 
 	// No additional parameters needed - all handled by ApplyDefaultsAndBuildParams
 
-	result, err := c.request(ctx, EndpointDefiTokenTrending, requestOptions{
+	trending, err := requestData[RespTokenTrendingList](ctx, c, EndpointDefiTokenTrending, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3372,12 +3809,6 @@ func (c *HTTPClient) GetTokenTrendingList(ctx context.Context, opts *TrendingLis
 		return nil, err
 	}
 
-	var trending RespTokenTrendingList
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &trending); err != nil {
-		return nil, err
-	}
-
 	return &trending, nil
 }
 
@@ -3443,24 +3874,12 @@ func (c *HTTPClient) GetNewListing(ctx context.Context, opts *NewListingOptions)
 
 	// No additional parameters needed - all handled by ApplyDefaultsAndBuildParams
 
-	result, err := c.request(ctx, EndpointDefiV2TokensNewListing, requestOptions{
+	return requestItems[RespTokenNewListing](ctx, c, EndpointDefiV2TokensNewListing, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var newListings map[string]RespTokenNewListing
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &newListings); err != nil {
-		return nil, err
-	}
-
-	return newListings["items"], nil
 }
 
 // ============================================================================
@@ -3548,7 +3967,7 @@ func (c *HTTPClient) GetWalletTrades(ctx context.Context, walletAddress string,
 		params["after_time"] = opts.AfterTime
 	}
 
-	result, err := c.request(ctx, EndpointTraderTxsSeekByTime, requestOptions{
+	trades, err := requestData[RespWalletTrades](ctx, c, EndpointTraderTxsSeekByTime, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3558,12 +3977,6 @@ func (c *HTTPClient) GetWalletTrades(ctx context.Context, walletAddress string,
 		return nil, err
 	}
 
-	var trades RespWalletTrades
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &trades); err != nil {
-		return nil, err
-	}
-
 	return &trades, nil
 }
 
@@ -3626,7 +4039,7 @@ func (c *HTTPClient) GetWalletTokenBalance(ctx context.Context, wallet, tokenAdd
 	params["wallet"] = wallet
 	params["token_address"] = tokenAddress
 
-	result, err := c.request(ctx, EndpointV1WalletTokenBalance, requestOptions{
+	balance, err := requestData[RespWalletTokenBalance](ctx, c, EndpointV1WalletTokenBalance, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3636,12 +4049,6 @@ func (c *HTTPClient) GetWalletTokenBalance(ctx context.Context, wallet, tokenAdd
 		return nil, err
 	}
 
-	var balance RespWalletTokenBalance
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &balance); err != nil {
-		return nil, err
-	}
-
 	return &balance, nil
 }
 
@@ -3728,7 +4135,7 @@ func (c *HTTPClient) GetWalletNetWorthHistories(ctx context.Context, wallet stri
 		params["time"] = opts.Time
 	}
 
-	result, err := c.request(ctx, EndpointV2WalletNetWorth, requestOptions{
+	histories, err := requestData[RespWalletNetWorthHistories](ctx, c, EndpointV2WalletNetWorth, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3738,12 +4145,6 @@ func (c *HTTPClient) GetWalletNetWorthHistories(ctx context.Context, wallet stri
 		return nil, err
 	}
 
-	var histories RespWalletNetWorthHistories
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &histories); err != nil {
-		return nil, err
-	}
-
 	return &histories, nil
 }
 
@@ -3772,7 +4173,9 @@ func (c *HTTPClient) GetWalletNetWorthHistories(ctx context.Context, wallet stri
 //	}
 //	fmt.Printf("Latest block: %d\n", blockNum)
 func (c *HTTPClient) GetLatestBlockNumber(ctx context.Context, chains []Chain) (int64, error) {
-	result, err := c.request(ctx, EndpointDefiV3TxsLatestBlock, requestOptions{
+	result, err := requestData[struct {
+		BlockNumber *float64 `json:"block_number"`
+	}](ctx, c, EndpointDefiV3TxsLatestBlock, requestOptions{
 		method: "GET",
 		chains: chains,
 	})
@@ -3780,8 +4183,8 @@ func (c *HTTPClient) GetLatestBlockNumber(ctx context.Context, chains []Chain) (
 		return 0, err
 	}
 
-	if blockNum, ok := result["block_number"].(float64); ok {
-		return int64(blockNum), nil
+	if result.BlockNumber != nil {
+		return int64(*result.BlockNumber), nil
 	}
 
 	return 0, errors.New("block_number not found in response")
@@ -3823,6 +4226,8 @@ type TokenTopTradersOptions struct {
 // This method retrieves information about the top traders for a specific token, including
 // their trading activity and statistics.
 //
+// The pagination metadata of the response is returned by GetTokenTopTradersWithMeta.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - address: Token contract address to query
@@ -3873,24 +4278,20 @@ func (c *HTTPClient) GetTokenTopTraders(ctx context.Context, address string, opt
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiV2TokensTopTraders, requestOptions{
+	return requestItems[RespTokenTopTraders](ctx, c, EndpointDefiV2TokensTopTraders, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var traders map[string]RespTokenTopTraders
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &traders); err != nil {
-		return nil, err
-	}
+}
 
-	return traders["items"], nil
+// GetTokenTopTradersWithMeta retrieves the top traders of a token like
+// GetTokenTopTraders, together with the pagination metadata of the response.
+func (c *HTTPClient) GetTokenTopTradersWithMeta(ctx context.Context, address string, opts *TokenTopTradersOptions) (*RespPage[RespTokenTopTraderItem], error) {
+	return withResponseMeta(ctx, func(ctx context.Context) ([]RespTokenTopTraderItem, error) {
+		return c.GetTokenTopTraders(ctx, address, opts)
+	})
 }
 
 // ============================================================================
@@ -3965,7 +4366,7 @@ func (c *HTTPClient) GetTokenAllMarketList(ctx context.Context, address string,
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiV2Markets, requestOptions{
+	marketList, err := requestData[RespTokenAllMarketList](ctx, c, EndpointDefiV2Markets, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -3975,12 +4376,6 @@ func (c *HTTPClient) GetTokenAllMarketList(ctx context.Context, address string,
 		return nil, err
 	}
 
-	var marketList RespTokenAllMarketList
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &marketList); err != nil {
-		return nil, err
-	}
-
 	return &marketList, nil
 }
 
@@ -4015,6 +4410,8 @@ type GainersLosersOptions struct {
 //
 // Note: This endpoint is only available for Solana chain.
 //
+// The pagination metadata of the response is returned by GetGainersLosersWithMeta.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - opts: Configuration options (see GainersLosersOptions for details)
@@ -4054,23 +4451,20 @@ func (c *HTTPClient) GetGainersLosers(ctx context.Context, opts *GainersLosersOp
 
 	// No additional parameters needed - all handled by ApplyDefaultsAndBuildParams
 
-	result, err := c.request(ctx, EndpointTraderGainersLosers, requestOptions{
+	return requestItems[RespGainerLosers](ctx, c, EndpointTraderGainersLosers, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var gainersLosers map[string]RespGainerLosers
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &gainersLosers); err != nil {
-		return nil, err
-	}
+}
 
-	return gainersLosers["items"], nil
+// GetGainersLosersWithMeta retrieves the top gainers or losers like
+// GetGainersLosers, together with the pagination metadata of the response.
+func (c *HTTPClient) GetGainersLosersWithMeta(ctx context.Context, opts *GainersLosersOptions) (*RespPage[RespGainerLoserItem], error) {
+	return withResponseMeta(ctx, func(ctx context.Context) ([]RespGainerLoserItem, error) {
+		return c.GetGainersLosers(ctx, opts)
+	})
 }
 
 // ============================================================================
@@ -4136,7 +4530,7 @@ func (c *HTTPClient) GetTokenAllTimeTrades(ctx context.Context, address string,
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiV3AllTimeTradesSingle, requestOptions{
+	trades, err := requestData[[]RespTokenAllTimeTrades](ctx, c, EndpointDefiV3AllTimeTradesSingle, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4146,25 +4540,9 @@ func (c *HTTPClient) GetTokenAllTimeTrades(ctx context.Context, address string,
 		return nil, err
 	}
 
-	// Result may be a list, get first element
-	data, _ := json.Marshal(result)
-
-	// Try to unmarshal as array first
-	// var tradesArray []RespTokenAllTimeTrades
-	// if err := json.Unmarshal(data, &tradesArray); err == nil && len(tradesArray) > 0 {
-	// 	return &tradesArray[0], nil
-	// }
-
-	// Otherwise unmarshal as single object
-	var trades map[string][]RespTokenAllTimeTrades
-	if err := json.Unmarshal(data, &trades); err != nil {
-		return nil, err
-	}
-
-	l := trades["data"]
-
-	if len(l) > 0 {
-		return &l[0], nil
+	// Result is a list, get first element
+	if len(trades) > 0 {
+		return &trades[0], nil
 	}
 
 	return nil, nil
@@ -4215,23 +4593,12 @@ func (c *HTTPClient) GetMultiTokenAllTimeTrades(ctx context.Context, addresses [
 	// Add required parameters
 	params["list_address"] = addresses
 
-	result, err := c.request(ctx, EndpointDefiV3AllTimeTradesMultiple, requestOptions{
+	return requestData[RespMultiTokenAllTimeTrades](ctx, c, EndpointDefiV3AllTimeTradesMultiple, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var trades map[string]RespMultiTokenAllTimeTrades
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &trades); err != nil {
-		return nil, err
-	}
-
-	return trades["data"], nil
 }
 
 // ============================================================================
@@ -4296,7 +4663,7 @@ func (c *HTTPClient) GetTokenPriceVolume(ctx context.Context, address string, op
 	// Add required parameters
 	params["address"] = address
 
-	result, err := c.request(ctx, EndpointDefiPriceVolumeSingle, requestOptions{
+	priceVolume, err := requestData[RespTokenPriceVolume](ctx, c, EndpointDefiPriceVolumeSingle, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4306,12 +4673,6 @@ func (c *HTTPClient) GetTokenPriceVolume(ctx context.Context, address string, op
 		return nil, err
 	}
 
-	var priceVolume RespTokenPriceVolume
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &priceVolume); err != nil {
-		return nil, err
-	}
-
 	return &priceVolume, nil
 }
 
@@ -4362,23 +4723,12 @@ func (c *HTTPClient) GetMultiTokenPriceVolume(ctx context.Context, addresses []s
 	// Add required parameters
 	params["list_address"] = addresses
 
-	result, err := c.request(ctx, EndpointDefiPriceVolumeMulti, requestOptions{
+	return requestData[map[string]RespTokenPriceVolume](ctx, c, EndpointDefiPriceVolumeMulti, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var priceVolumes map[string]RespTokenPriceVolume
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &priceVolumes); err != nil {
-		return nil, err
-	}
-
-	return priceVolumes, nil
 }
 
 // ============================================================================
@@ -4459,7 +4809,7 @@ func (c *HTTPClient) GetTokenPriceHistories(ctx context.Context, address, addres
 	params["time_from"] = timeFrom
 	params["time_to"] = timeTo
 
-	result, err := c.request(ctx, EndpointDefiHistoryPrice, requestOptions{
+	histories, err := requestData[RespTokenPriceHistories](ctx, c, EndpointDefiHistoryPrice, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4469,12 +4819,6 @@ func (c *HTTPClient) GetTokenPriceHistories(ctx context.Context, address, addres
 		return nil, err
 	}
 
-	var histories RespTokenPriceHistories
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &histories); err != nil {
-		return nil, err
-	}
-
 	return &histories, nil
 }
 
@@ -4531,7 +4875,7 @@ func (c *HTTPClient) GetTokenPriceHistoryByTime(ctx context.Context, address str
 	params["address"] = address
 	params["unixtime"] = unixTime
 
-	result, err := c.request(ctx, EndpointDefiHistoricalPriceUnix, requestOptions{
+	history, err := requestData[RespTokenPriceHistoryByTime](ctx, c, EndpointDefiHistoricalPriceUnix, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4541,12 +4885,6 @@ func (c *HTTPClient) GetTokenPriceHistoryByTime(ctx context.Context, address str
 		return nil, err
 	}
 
-	var history RespTokenPriceHistoryByTime
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &history); err != nil {
-		return nil, err
-	}
-
 	return &history, nil
 }
 
@@ -4627,7 +4965,7 @@ func (c *HTTPClient) GetTokenOHLCVV3(ctx context.Context, address, intervalType
 	params["padding"] = fmt.Sprintf("%t", opts.Padding)
 	params["outlier"] = fmt.Sprintf("%t", opts.Outlier)
 
-	result, err := c.request(ctx, EndpointDefiV3OHLCV, requestOptions{
+	ohlcv, err := requestData[RespTokenOHLCVsV3](ctx, c, EndpointDefiV3OHLCV, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4637,12 +4975,6 @@ func (c *HTTPClient) GetTokenOHLCVV3(ctx context.Context, address, intervalType
 		return nil, err
 	}
 
-	var ohlcv RespTokenOHLCVsV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &ohlcv); err != nil {
-		return nil, err
-	}
-
 	return &ohlcv, nil
 }
 
@@ -4698,24 +5030,12 @@ func (c *HTTPClient) GetPairOHLCVV3(ctx context.Context, address, intervalType s
 	params["padding"] = fmt.Sprintf("%t", opts.Padding)
 	params["outlier"] = fmt.Sprintf("%t", opts.Outlier)
 
-	result, err := c.request(ctx, EndpointDefiV3OHLCVPair, requestOptions{
+	return requestItems[[]RespPairOHLCVItemV3](ctx, c, EndpointDefiV3OHLCVPair, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var ohlcv map[string][]RespPairOHLCVItemV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &ohlcv); err != nil {
-		return nil, err
-	}
-
-	return ohlcv["items"], nil
 }
 
 // ============================================================================
@@ -4770,7 +5090,7 @@ func (c *HTTPClient) GetTokenPriceStats(ctx context.Context, address string, tim
 	params["address"] = address
 	params["list_timeframe"] = timeframes
 
-	result, err := c.request(ctx, EndpointDefiV3PriceStatsSingle, requestOptions{
+	data, err := requestData[json.RawMessage](ctx, c, EndpointDefiV3PriceStatsSingle, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4781,18 +5101,22 @@ func (c *HTTPClient) GetTokenPriceStats(ctx context.Context, address string, tim
 	}
 
 	// Result may be a list, get first element
-	var stats RespTokenPriceStats
-	data, _ := json.Marshal(result)
-
-	// Try to unmarshal as array first
-	var statsArray []RespTokenPriceStats
-	if err := json.Unmarshal(data, &statsArray); err == nil && len(statsArray) > 0 {
-		return &statsArray[0], nil
+	if isJSONArray(data) {
+		var statsArray []RespTokenPriceStats
+		if err := json.Unmarshal(data, &statsArray); err != nil {
+			return nil, err
+		}
+		if len(statsArray) > 0 {
+			return &statsArray[0], nil
+		}
 	}
 
 	// Otherwise unmarshal as single object
-	if err := json.Unmarshal(data, &stats); err != nil {
-		return nil, err
+	var stats RespTokenPriceStats
+	if isJSONObject(data) {
+		if err := json.Unmarshal(data, &stats); err != nil {
+			return nil, err
+		}
 	}
 
 	return &stats, nil
@@ -4832,23 +5156,12 @@ func (c *HTTPClient) GetMultiTokenPriceStats(ctx context.Context, addresses, tim
 
 	path := EndpointDefiV3PriceStatsMultiple + "?" + "&list_timeframe=" + strings.Join(timeframes, ",") + "&ui_amount_mode=" + params["ui_amount_mode"].(string)
 
-	result, err := c.request(ctx, path, requestOptions{
+	return requestData[RespMultiTokenPriceStats](ctx, c, path, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    map[string]any{"list_address": strings.Join(addresses, ",")},
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var stats map[string]RespMultiTokenPriceStats
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &stats); err != nil {
-		return nil, err
-	}
-
-	return stats["data"], nil
 }
 
 // ============================================================================
@@ -4884,6 +5197,8 @@ type TokenMintBurnTxsOptions struct {
 //
 // Note: This endpoint is only available for Solana chain.
 //
+// The pagination metadata of the response is returned by GetTokenMintBurnTxsWithMeta.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - address: Token contract address to query
@@ -4917,24 +5232,21 @@ func (c *HTTPClient) GetTokenMintBurnTxs(ctx context.Context, address string, op
 		params["before_time"] = opts.BeforeTime
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenMintBurnTxs, requestOptions{
+	return requestItems[RespTokenMintBurnTxs](ctx, c, EndpointDefiV3TokenMintBurnTxs, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var txs map[string]RespTokenMintBurnTxs
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
+}
 
-	return txs["items"], nil
+// GetTokenMintBurnTxsWithMeta retrieves the mint and burn transactions of a
+// token like GetTokenMintBurnTxs, together with the pagination metadata of the
+// response.
+func (c *HTTPClient) GetTokenMintBurnTxsWithMeta(ctx context.Context, address string, opts *TokenMintBurnTxsOptions) (*RespPage[RespTokenMintBurnTxItem], error) {
+	return withResponseMeta(ctx, func(ctx context.Context) ([]RespTokenMintBurnTxItem, error) {
+		return c.GetTokenMintBurnTxs(ctx, address, opts)
+	})
 }
 
 // ============================================================================
@@ -4976,7 +5288,7 @@ func (c *HTTPClient) GetTokenExitLiquidity(ctx context.Context, address string,
 		"address": address,
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenExitLiquidity, requestOptions{
+	exitLiquidity, err := requestData[RespTokenExitLiquidity](ctx, c, EndpointDefiV3TokenExitLiquidity, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -4986,12 +5298,6 @@ func (c *HTTPClient) GetTokenExitLiquidity(ctx context.Context, address string,
 		return nil, err
 	}
 
-	var exitLiquidity RespTokenExitLiquidity
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &exitLiquidity); err != nil {
-		return nil, err
-	}
-
 	return &exitLiquidity, nil
 }
 
@@ -5022,23 +5328,12 @@ func (c *HTTPClient) GetMultiTokenExitLiquidity(ctx context.Context, addresses [
 		"list_address": addresses,
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenExitLiquidityMultiple, requestOptions{
+	return requestData[[]RespTokenExitLiquidity](ctx, c, EndpointDefiV3TokenExitLiquidityMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var exitLiquidity []RespTokenExitLiquidity
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &exitLiquidity); err != nil {
-		return nil, err
-	}
-
-	return exitLiquidity, nil
 }
 
 // ============================================================================
@@ -5107,7 +5402,7 @@ func (c *HTTPClient) GetMemeList(ctx context.Context, opts *MemeListOptions) (*R
 		params["graduated"] = "true"
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenMemeList, requestOptions{
+	memeList, err := requestData[RespMemeList](ctx, c, EndpointDefiV3TokenMemeList, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5117,12 +5412,6 @@ func (c *HTTPClient) GetMemeList(ctx context.Context, opts *MemeListOptions) (*R
 		return nil, err
 	}
 
-	var memeList RespMemeList
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &memeList); err != nil {
-		return nil, err
-	}
-
 	return &memeList, nil
 }
 
@@ -5161,7 +5450,7 @@ func (c *HTTPClient) GetMemeDetail(ctx context.Context, address string, opts *Me
 		"address": address,
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenMemeDetailSingle, requestOptions{
+	detail, err := requestData[RespMemeDetail](ctx, c, EndpointDefiV3TokenMemeDetailSingle, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5171,12 +5460,6 @@ func (c *HTTPClient) GetMemeDetail(ctx context.Context, address string, opts *Me
 		return nil, err
 	}
 
-	var detail RespMemeDetail
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &detail); err != nil {
-		return nil, err
-	}
-
 	return &detail, nil
 }
 
@@ -5220,7 +5503,7 @@ func (c *HTTPClient) GetWalletTokensPnL(ctx context.Context, wallet string, toke
 		"token_addresses": tokenAddresses,
 	}
 
-	result, err := c.request(ctx, EndpointV2WalletPnl, requestOptions{
+	pnl, err := requestData[RespWalletTokensPnL](ctx, c, EndpointV2WalletPnl, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5230,12 +5513,6 @@ func (c *HTTPClient) GetWalletTokensPnL(ctx context.Context, wallet string, toke
 		return nil, err
 	}
 
-	var pnl RespWalletTokensPnL
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &pnl); err != nil {
-		return nil, err
-	}
-
 	return &pnl, nil
 }
 
@@ -5267,7 +5544,7 @@ func (c *HTTPClient) GetWalletsPnLByToken(ctx context.Context, tokenAddress stri
 		"wallets":       wallets,
 	}
 
-	result, err := c.request(ctx, EndpointV2WalletPnlMultiple, requestOptions{
+	pnl, err := requestData[RespWalletsPnLByToken](ctx, c, EndpointV2WalletPnlMultiple, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5277,12 +5554,6 @@ func (c *HTTPClient) GetWalletsPnLByToken(ctx context.Context, tokenAddress stri
 		return nil, err
 	}
 
-	var pnl RespWalletsPnLByToken
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &pnl); err != nil {
-		return nil, err
-	}
-
 	return &pnl, nil
 }
 
@@ -5320,7 +5591,7 @@ func (c *HTTPClient) GetWalletTokensBalance(ctx context.Context, wallet string,
 	if opts == nil {
 		opts = &WalletTokensBalanceOptions{}
 	}
-	result, err := c.request(ctx, EndpointV2WalletTokenBalance, requestOptions{
+	return requestItems[RespWalletTokensBalances](ctx, c, EndpointV2WalletTokenBalance, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5330,17 +5601,6 @@ func (c *HTTPClient) GetWalletTokensBalance(ctx context.Context, wallet string,
 		},
 		paramsUseArray: true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var balances map[string]RespWalletTokensBalances
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &balances); err != nil {
-		return nil, err
-	}
-
-	return balances["items"], nil
 }
 
 // GetWalletTokenFirstTx retrieves first transaction for a wallet and token.
@@ -5367,7 +5627,7 @@ func (c *HTTPClient) GetWalletTokenFirstTx(ctx context.Context, wallets []string
 	if opts == nil {
 		opts = &WalletTokensBalanceOptions{}
 	}
-	result, err := c.request(ctx, EndpointV2WalletTxFirstFunded, requestOptions{
+	return requestData[map[string]RespWalletTokenFirstTx](ctx, c, EndpointV2WalletTxFirstFunded, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5377,17 +5637,6 @@ func (c *HTTPClient) GetWalletTokenFirstTx(ctx context.Context, wallets []string
 		},
 		paramsUseArray: true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var firstTx map[string]RespWalletTokenFirstTx
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &firstTx); err != nil {
-		return nil, err
-	}
-
-	return firstTx, nil
 }
 
 // ============================================================================
@@ -5455,7 +5704,7 @@ func (c *HTTPClient) GetWalletNetWorthDetails(ctx context.Context, wallet string
 		params["time"] = opts.Time
 	}
 
-	result, err := c.request(ctx, EndpointV2WalletNetWorthDetails, requestOptions{
+	details, err := requestData[RespWalletNetWorthDetails](ctx, c, EndpointV2WalletNetWorthDetails, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5465,12 +5714,6 @@ func (c *HTTPClient) GetWalletNetWorthDetails(ctx context.Context, wallet string
 		return nil, err
 	}
 
-	var details RespWalletNetWorthDetails
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &details); err != nil {
-		return nil, err
-	}
-
 	return &details, nil
 }
 
@@ -5521,24 +5764,13 @@ func (c *HTTPClient) GetTokenHolderBatch(ctx context.Context, tokenAddress strin
 	params["token_address"] = tokenAddress
 	params["wallets"] = wallets
 
-	result, err := c.request(ctx, EndpointTokenV1HolderBatch, requestOptions{
+	return requestItems[RespTokenHolderBatch](ctx, c, EndpointTokenV1HolderBatch, requestOptions{
 		method:          "POST",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
 		paramsUseArray:  true,
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	var holders map[string]RespTokenHolderBatch
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &holders); err != nil {
-		return nil, err
-	}
-
-	return holders["items"], nil
 }
 
 // ============================================================================
@@ -5602,7 +5834,7 @@ func (c *HTTPClient) GetTokenListV1(ctx context.Context, opts *TokenListV1Option
 		params["max_liquidity"] = opts.MaxLiquidity
 	}
 
-	result, err := c.request(ctx, EndpointDefiTokenList, requestOptions{
+	tokenList, err := requestData[RespTokenListV1](ctx, c, EndpointDefiTokenList, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5612,12 +5844,6 @@ func (c *HTTPClient) GetTokenListV1(ctx context.Context, opts *TokenListV1Option
 		return nil, err
 	}
 
-	var tokenList RespTokenListV1
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &tokenList); err != nil {
-		return nil, err
-	}
-
 	return &tokenList, nil
 }
 
@@ -5716,7 +5942,7 @@ func (c *HTTPClient) GetAllTxs(ctx context.Context, opts *AllTxsV3Options) (*Res
 		params["after_block_number"] = opts.AfterBlockNumber
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3Txs, requestOptions{
+	txs, err := requestData[RespAllTxsV3](ctx, c, EndpointDefiV3Txs, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5726,12 +5952,6 @@ func (c *HTTPClient) GetAllTxs(ctx context.Context, opts *AllTxsV3Options) (*Res
 		return nil, err
 	}
 
-	var txs RespAllTxsV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -5805,7 +6025,7 @@ func (c *HTTPClient) GetRecentTxs(ctx context.Context, opts *RecentTxsV3Options)
 		params["after_time"] = opts.AfterTime
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3Txs, requestOptions{
+	txs, err := requestData[RespRecentTxsV3](ctx, c, EndpointDefiV3Txs, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5815,12 +6035,6 @@ func (c *HTTPClient) GetRecentTxs(ctx context.Context, opts *RecentTxsV3Options)
 		return nil, err
 	}
 
-	var txs RespRecentTxsV3
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &txs); err != nil {
-		return nil, err
-	}
-
 	return &txs, nil
 }
 
@@ -5883,7 +6097,7 @@ func (c *HTTPClient) GetOHLCVBaseQuote(ctx context.Context, baseAddress, quoteAd
 	params["time_from"] = timeFrom
 	params["time_to"] = timeTo
 
-	result, err := c.request(ctx, EndpointDefiOHLCVBaseQuote, requestOptions{
+	ohlcv, err := requestData[RespOHLCVBaseQuote](ctx, c, EndpointDefiOHLCVBaseQuote, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -5893,12 +6107,6 @@ func (c *HTTPClient) GetOHLCVBaseQuote(ctx context.Context, baseAddress, quoteAd
 		return nil, err
 	}
 
-	var ohlcv RespOHLCVBaseQuote
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &ohlcv); err != nil {
-		return nil, err
-	}
-
 	return &ohlcv, nil
 }
 
@@ -5979,7 +6187,7 @@ type TokenListV3ScrollOptions struct {
 //   - *RespTokenListV3Scroll: Token list response containing:
 //   - items: List of token information
 //   - hasNext: Whether more tokens are available
-//   - scrollID: Pagination cursor for next request
+//   - scrollID / nextScrollID: Pagination cursor for next request, see NextCursor
 //   - error: Error if request fails or validation fails
 //
 // Raises:
@@ -6012,7 +6220,7 @@ func (c *HTTPClient) GetTokenListV3Scroll(ctx context.Context, opts *TokenListV3
 		params["max_liquidity"] = opts.MaxLiquidity
 	}
 
-	result, err := c.request(ctx, EndpointDefiV3TokenListScroll, requestOptions{
+	tokenList, err := requestData[RespTokenListV3Scroll](ctx, c, EndpointDefiV3TokenListScroll, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
@@ -6022,12 +6230,6 @@ func (c *HTTPClient) GetTokenListV3Scroll(ctx context.Context, opts *TokenListV3
 		return nil, err
 	}
 
-	var tokenList RespTokenListV3Scroll
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &tokenList); err != nil {
-		return nil, err
-	}
-
 	return &tokenList, nil
 }
 
@@ -6102,6 +6304,8 @@ type WalletBalanceChangesOptions struct {
 //
 // Note: This endpoint is only available for Solana chain.
 //
+// The pagination metadata of the response is returned by GetWalletBalanceChangesWithMeta.
+//
 // Parameters:
 //   - ctx: Context for cancellation and timeout control
 //   - wallet: Wallet address to query
@@ -6145,22 +6349,55 @@ func (c *HTTPClient) GetWalletBalanceChanges(ctx context.Context, wallet, tokenA
 		params["change_type"] = opts.ChangeType
 	}
 
-	result, err := c.request(ctx, EndpointV1WalletTokenBalance, requestOptions{
+	return requestItems[RespWalletBalanceChanges](ctx, c, EndpointV1WalletTokenBalance, requestOptions{
 		method:          "GET",
 		chains:          opts.Chains,
 		onLimitExceeded: RateLimitBehavior(opts.OnLimitExceeded),
 		paramsOrBody:    params,
-		respJustItems:   true,
 	})
-	if err != nil {
-		return nil, err
-	}
+}
+
+// GetWalletBalanceChangesWithMeta retrieves the balance changes of a wallet
+// like GetWalletBalanceChanges, together with the pagination metadata of the
+// response.
+func (c *HTTPClient) GetWalletBalanceChangesWithMeta(ctx context.Context, wallet, tokenAddress string, opts *WalletBalanceChangesOptions) (*RespPage[RespWalletBalanceChangesItem], error) {
+	return withResponseMeta(ctx, func(ctx context.Context) ([]RespWalletBalanceChangesItem, error) {
+		return c.GetWalletBalanceChanges(ctx, wallet, tokenAddress, opts)
+	})
+}
+
+// ============================================================================
+// API Methods - Credits Usage
+// ============================================================================
 
-	var changes map[string]RespWalletBalanceChanges
-	data, _ := json.Marshal(result)
-	if err := json.Unmarshal(data, &changes); err != nil {
+// GetCreditsUsage retrieves the compute unit (credit) usage of the API key.
+//
+// This method reports the usage recorded by Birdeye for the current billing cycle.
+// Long-running workers can compare it with GetComputeUnitUsage to reconcile local
+// cost estimates and throttle themselves before reaching their plan's cap.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//
+// Returns:
+//   - *RespCreditsUsage: Usage of the API key, split by API and WebSocket
+//   - error: Error if the API request fails or if the response cannot be parsed
+//
+// Example:
+//
+//	credits, err := client.GetCreditsUsage(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Used: %.0f CU (API %.0f, WebSocket %.0f)\n",
+//	    credits.Usage.Total, credits.Usage.API, credits.Usage.Websocket)
+func (c *HTTPClient) GetCreditsUsage(ctx context.Context) (*RespCreditsUsage, error) {
+	usage, err := requestData[RespCreditsUsage](ctx, c, EndpointUtilsV1Credits, requestOptions{
+		method: "GET",
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return changes["items"], nil
+	return &usage, nil
 }
@@ -2,8 +2,15 @@ package birdeye
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -45,6 +52,93 @@ func getTestBSCClient(t *testing.T) *HTTPClient {
 	return client
 }
 
+// newMockClient returns a client pointed at a local test server
+func newMockClient(t *testing.T, handler http.HandlerFunc, policy *RetryPolicy) *HTTPClient {
+	t.Helper()
+	client, _ := newRecordingClient(t, HTTPClientConfig{RetryPolicy: policy}, handler)
+	return client
+}
+
+// newRecordingClient returns a client configured by config and pointed at a
+// local test server, with a recorder of the requests the server received.
+// config.APIKey defaults to "test-key" when no API keys are set.
+func newRecordingClient(t *testing.T, config HTTPClientConfig, handler http.HandlerFunc) (*HTTPClient, *requestRecorder) {
+	t.Helper()
+	rec := &requestRecorder{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec.add(r)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	config.BaseURL = server.URL
+	if config.APIKey == "" && len(config.APIKeys) == 0 {
+		config.APIKey = "test-key"
+	}
+	return NewHTTPClient(config), rec
+}
+
+// skippingConfig returns a client configuration that lets n requests to
+// endpoint through and skips the following ones.
+func skippingConfig(t *testing.T, endpoint string, n int) HTTPClientConfig {
+	t.Helper()
+	limiter, err := NewRateLimiter(n, time.Hour, RateLimitSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return HTTPClientConfig{
+		OnLimitExceeded:  RateLimitSkip,
+		EndpointLimiters: map[string]Limiter{endpoint: limiter},
+	}
+}
+
+// requestRecorder records the requests received by a mock server.
+type requestRecorder struct {
+	mu       sync.Mutex
+	requests []*http.Request
+}
+
+func (rec *requestRecorder) add(r *http.Request) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.requests = append(rec.requests, r.Clone(context.Background()))
+}
+
+// all returns the requests received so far, oldest first.
+func (rec *requestRecorder) all() []*http.Request {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return slices.Clone(rec.requests)
+}
+
+// count returns the number of requests received so far.
+func (rec *requestRecorder) count() int {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return len(rec.requests)
+}
+
+// query returns the values of a query parameter over the requests received
+// on path, or on any path if path is empty.
+func (rec *requestRecorder) query(path, param string) []string {
+	var values []string
+	for _, r := range rec.all() {
+		if path == "" || r.URL.Path == path {
+			values = append(values, r.URL.Query().Get(param))
+		}
+	}
+	return values
+}
+
+// countBy returns the number of requests received per value of key.
+func (rec *requestRecorder) countBy(key func(*http.Request) string) map[string]int {
+	counts := make(map[string]int)
+	for _, r := range rec.all() {
+		counts[key(r)]++
+	}
+	return counts
+}
+
 // Test Network Support APIs
 func TestGetSupportedNetworks(t *testing.T) {
 	client := getTestClient(t)
@@ -1055,3 +1149,412 @@ func TestGetRecentTxs(t *testing.T) {
 		}
 	}
 }
+
+// Test error mapping
+func TestAPIErrorSentinels(t *testing.T) {
+	cases := []struct {
+		status   int
+		sentinel error
+	}{
+		{http.StatusBadRequest, ErrBadRequest},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnprocessableEntity, ErrUnprocessableEntity},
+		{http.StatusTooManyRequests, ErrTooManyRequests},
+		{http.StatusInternalServerError, ErrInternalServer},
+		{http.StatusBadGateway, ErrInternalServer},
+		{http.StatusGatewayTimeout, ErrTimeout},
+	}
+
+	for _, tc := range cases {
+		client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("X-Request-Id", "req-123")
+			w.WriteHeader(tc.status)
+			w.Write([]byte(`{"success":false,"message":"failed"}`))
+		}, &RetryPolicy{MaxAttempts: 1})
+
+		_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+		if !errors.Is(err, tc.sentinel) {
+			t.Errorf("status %d: expected %v, got %v", tc.status, tc.sentinel, err)
+		}
+		if !errors.Is(err, &BirdeyeAPIError{StatusCode: tc.status}) {
+			t.Errorf("status %d: expected match on status code", tc.status)
+		}
+
+		var apiErr *BirdeyeAPIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("status %d: expected *BirdeyeAPIError, got %T", tc.status, err)
+		}
+		if apiErr.Endpoint != EndpointDefiPrice {
+			t.Errorf("Expected endpoint %s, got %s", EndpointDefiPrice, apiErr.Endpoint)
+		}
+		if apiErr.Params["address"] != testTokenSOL {
+			t.Errorf("Expected params to carry address, got %v", apiErr.Params)
+		}
+		if apiErr.RequestID != "req-123" {
+			t.Errorf("Expected request ID req-123, got %q", apiErr.RequestID)
+		}
+	}
+}
+
+func TestRedactParams(t *testing.T) {
+	params := map[string]any{"address": testTokenSOL, "X-API-KEY": "secret-key"}
+	redacted := redactParams(params)
+
+	if redacted["X-API-KEY"] != "REDACTED" {
+		t.Errorf("Expected key to be redacted, got %v", redacted["X-API-KEY"])
+	}
+	if redacted["address"] != testTokenSOL {
+		t.Errorf("Expected address to be kept, got %v", redacted["address"])
+	}
+	if params["X-API-KEY"] != "secret-key" {
+		t.Error("redactParams must not modify its input")
+	}
+}
+
+// Test typed response decoding
+func TestDecodeData(t *testing.T) {
+	t.Run("Object with pagination", func(t *testing.T) {
+		body := []byte(`{"success":true,"data":{"wallet_address":"w","items":[{"symbol":"SOL"}]},"pagination":{"limit":1,"offset":0,"total":5}}`)
+		netWorth, err := decodeData[RespWalletNetWorth](body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if netWorth.WalletAddress != "w" || len(netWorth.Items) != 1 {
+			t.Errorf("Unexpected data: %+v", netWorth)
+		}
+		if netWorth.Pagination.Total != 5 {
+			t.Errorf("Expected pagination total 5, got %d", netWorth.Pagination.Total)
+		}
+	})
+
+	t.Run("Embedded response meta", func(t *testing.T) {
+		body := []byte(`{"success":true,"data":{"items":[{"address":"a"}],"has_next":true},"pagination":{"limit":1,"offset":2,"total":40}}`)
+		list, err := decodeData[RespTokenListV3](body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.Items) != 1 || list.Pagination == nil || *list.Pagination != (Pagination{Limit: 1, Offset: 2, Total: 40}) {
+			t.Errorf("Unexpected list: %+v", list)
+		}
+
+		list, err = decodeData[RespTokenListV3]([]byte(`{"success":true,"data":{"items":[]}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list.Pagination != nil {
+			t.Errorf("Expected no pagination, got %+v", list.Pagination)
+		}
+	})
+
+	t.Run("Array data", func(t *testing.T) {
+		chains, err := decodeData[[]Chain]([]byte(`{"success":true,"data":["solana","ethereum"]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(chains) != 2 || chains[0] != ChainSolana {
+			t.Errorf("Unexpected chains: %v", chains)
+		}
+	})
+
+	t.Run("Invalid JSON", func(t *testing.T) {
+		if _, err := decodeData[RespTokenPrice]([]byte(`<html>`)); err == nil {
+			t.Error("Expected error for invalid JSON")
+		}
+	})
+}
+
+func TestDecodeItems(t *testing.T) {
+	for _, body := range []string{
+		`{"success":true,"data":{"items":[{"owner":"a"},{"owner":"b"}]}}`,
+		`{"success":true,"data":[{"owner":"a"},{"owner":"b"}],"pagination":{"total":2}}`,
+	} {
+		holders, err := decodeItems[RespTokenHolders]([]byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(holders) != 2 || holders[1].Owner != "b" {
+			t.Errorf("Unexpected holders: %+v", holders)
+		}
+	}
+}
+
+func TestWithResponseMeta(t *testing.T) {
+	bodies := []string{
+		`{"success":true,"data":[{"owner":"a"},{"owner":"b"}],"pagination":{"limit":2,"offset":0,"total":9}}`,
+		`{"success":true,"data":{"items":[{"owner":"a"}],"pagination":{"limit":1,"offset":4,"total":7}}}`,
+		`{"success":true,"data":{"items":[{"owner":"a"}]}}`,
+	}
+	want := []*Pagination{{Limit: 2, Total: 9}, {Limit: 1, Offset: 4, Total: 7}, nil}
+
+	for i, body := range bodies {
+		client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(body))
+		}, nil)
+
+		meta := ResponseMeta{Pagination: &Pagination{Total: -1}}
+		holders, err := client.GetTokenHolders(WithResponseMeta(context.Background(), &meta), testTokenSOL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(holders) == 0 {
+			t.Errorf("body %d: no holders decoded", i)
+		}
+		if (meta.Pagination == nil) != (want[i] == nil) || (want[i] != nil && *meta.Pagination != *want[i]) {
+			t.Errorf("body %d: pagination %+v, want %+v", i, meta.Pagination, want[i])
+		}
+
+		page, err := client.GetTokenHoldersWithMeta(context.Background(), testTokenSOL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page.Items) != len(holders) {
+			t.Errorf("body %d: %d items with meta, want %d", i, len(page.Items), len(holders))
+		}
+		if (page.Pagination == nil) != (want[i] == nil) || (want[i] != nil && *page.Pagination != *want[i]) {
+			t.Errorf("body %d: page pagination %+v, want %+v", i, page.Pagination, want[i])
+		}
+	}
+}
+
+// tokenListFixture builds a token list page shaped like a GetTokenListV3Scroll response
+func tokenListFixture(n int) []byte {
+	items := make([]RespTokenListV3TokenItem, n)
+	for i := range items {
+		website := fmt.Sprintf("https://token%d.example", i)
+		items[i] = RespTokenListV3TokenItem{
+			Address:           fmt.Sprintf("Token%039d", i),
+			LogoURI:           fmt.Sprintf("https://img.example/%d.png", i),
+			Name:              fmt.Sprintf("Token %d", i),
+			Symbol:            fmt.Sprintf("TK%d", i),
+			Decimals:          9,
+			Extensions:        TokenExtensions{Website: &website},
+			MarketCap:         1234567.89 * float64(i+1),
+			FDV:               2345678.91 * float64(i+1),
+			Liquidity:         98765.4321,
+			LastTradeUnixTime: 1700000000 + int64(i),
+			Volume24hUSD:      4567.891,
+			Trade24hCount:     int64(i * 3),
+			Price:             0.000123 * float64(i+1),
+			Holder:            int64(i * 17),
+		}
+	}
+	body, _ := json.Marshal(map[string]any{
+		"success": true,
+		"data":    RespTokenListV3Scroll{Items: items, HasNext: true},
+	})
+	return body
+}
+
+// tokenTxsFixture builds a tx page shaped like a GetTokenTxsV3 response
+func tokenTxsFixture(n int) []byte {
+	items := make([]RespTokenTxsItemV3, n)
+	for i := range items {
+		items[i] = RespTokenTxsItemV3{
+			TxType:        TxTypeSwap,
+			TxHash:        fmt.Sprintf("%088d", i),
+			InsIndex:      int64(i % 7),
+			BlockUnixTime: 1700000000 + int64(i),
+			BlockNumber:   250000000 + int64(i),
+			VolumeUSD:     123.456,
+			Owner:         testWalletAddr,
+			Signers:       []string{testWalletAddr},
+			Source:        "raydium",
+			Side:          TradeSideBuy,
+			From:          TokenInfo{Symbol: "SOL", Address: testTokenSOL, Decimals: 9, Amount: "1000000000", UIAmount: 1},
+			To:            TokenInfo{Symbol: "USDC", Address: testTokenUSDC, Decimals: 6, Amount: "150000000", UIAmount: 150},
+			PoolID:        testPairAddress,
+		}
+	}
+	body, _ := json.Marshal(map[string]any{
+		"success": true,
+		"data":    RespTokenTxsV3{Items: items, HasNext: true},
+	})
+	return body
+}
+
+// legacyDecode mirrors the former map -> JSON -> struct decode path
+func legacyDecode(body []byte, out any) error {
+	var result map[string]any
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+	data, _ := json.Marshal(result["data"])
+	return json.Unmarshal(data, out)
+}
+
+func BenchmarkDecodeTokenListScroll(b *testing.B) {
+	body := tokenListFixture(5000)
+
+	b.Run("Legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var out RespTokenListV3Scroll
+			if err := legacyDecode(body, &out); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Typed", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := decodeData[RespTokenListV3Scroll](body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkDecodeTokenTxsV3(b *testing.B) {
+	body := tokenTxsFixture(100)
+
+	b.Run("Legacy", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			var out RespTokenTxsV3
+			if err := legacyDecode(body, &out); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Typed", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := decodeData[RespTokenTxsV3](body); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// countingLimiter is a Limiter that records acquisitions
+type countingLimiter struct {
+	acquired atomic.Int32
+}
+
+func (l *countingLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error) {
+	l.acquired.Add(int32(tokens))
+	return true, nil
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	_, err := l.Acquire(ctx, 1, nil)
+	return err
+}
+
+func (l *countingLimiter) GetStatus() []LimiterStatus {
+	return []LimiterStatus{{Limit: 1, Period: time.Second, AvailableTokens: 1}}
+}
+
+// Test pluggable limiters
+func TestCustomLimiters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+	}))
+	defer server.Close()
+
+	category := &countingLimiter{}
+	endpoint := &countingLimiter{}
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:           "test-key",
+		BaseURL:          server.URL,
+		CategoryLimiters: map[EndpointCategory]Limiter{EndpointCategoryMarketData: category},
+		EndpointLimiters: map[string]Limiter{EndpointDefiV3TokenMarketData: endpoint},
+	})
+
+	ctx := context.Background()
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetTokenMarketData(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if category.acquired.Load() != 1 {
+		t.Errorf("Expected 1 acquisition on category limiter, got %d", category.acquired.Load())
+	}
+	if endpoint.acquired.Load() != 1 {
+		t.Errorf("Expected 1 acquisition on endpoint limiter, got %d", endpoint.acquired.Load())
+	}
+
+	// Untouched categories keep their built-in limiter
+	status := client.GetLimiterStatus(EndpointV1WalletTokenList)
+	if len(status) != 2 || status[0].Limit != 30 || status[1].Limit != 150 {
+		t.Errorf("Unexpected wallet limiter status: %+v", status)
+	}
+}
+
+func TestRateLimitProfile(t *testing.T) {
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:           "test-key",
+		RateLimitProfile: &RateLimitProfileStandard,
+	})
+	for _, ep := range []string{EndpointDefiPrice, EndpointDefiTokenList, EndpointDefiTxsToken, EndpointV1WalletTokenList} {
+		status := client.GetLimiterStatus(ep)
+		if len(status) != 1 || status[0].Limit != 1 || status[0].Period != time.Second {
+			t.Errorf("%s: expected 1 RPS, got %+v", ep, status)
+		}
+	}
+
+	// Categories missing from a custom profile use its historical limits
+	client = NewHTTPClient(HTTPClientConfig{
+		APIKey: "test-key",
+		RateLimitProfile: &RateLimitProfile{
+			Name: "custom",
+			Categories: map[EndpointCategory][]RateLimit{
+				EndpointCategoryHistorical: {{Limit: 20, Period: time.Second}},
+				EndpointCategoryWallet:     {{Limit: 5, Period: time.Second}, {Limit: 60, Period: time.Minute}},
+			},
+		},
+	})
+	if status := client.GetLimiterStatus(EndpointDefiPrice); len(status) != 1 || status[0].Limit != 20 {
+		t.Errorf("Expected fallback to historical limits, got %+v", status)
+	}
+	if status := client.GetLimiterStatus(EndpointV1WalletTokenList); len(status) != 2 || status[0].Limit != 5 || status[1].Limit != 60 {
+		t.Errorf("Unexpected wallet limiter status: %+v", status)
+	}
+
+	// Default profile is Business
+	client = NewHTTPClient(HTTPClientConfig{APIKey: "test-key"})
+	if status := client.GetLimiterStatus(EndpointDefiPrice); len(status) != 1 || status[0].Limit != 300 {
+		t.Errorf("Expected business market data limit, got %+v", status)
+	}
+}
+
+// Test Credits Usage API
+func TestGetCreditsUsage(t *testing.T) {
+	client := getTestClient(t)
+	ctx := context.Background()
+
+	credits, err := client.GetCreditsUsage(ctx)
+	if err != nil {
+		t.Fatalf("GetCreditsUsage failed: %v", err)
+	}
+
+	if credits.Usage.Total < 0 {
+		t.Errorf("Expected non-negative usage, got %f", credits.Usage.Total)
+	}
+}
+
+func TestGetCreditsUsageDecode(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != EndpointUtilsV1Credits {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"success":true,"data":{"usage":{"api":1200,"websocket":300,"total":1500},"remaining_credit":8500}}`))
+	}, nil)
+
+	credits, err := client.GetCreditsUsage(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credits.Usage.API != 1200 || credits.Usage.Total != 1500 || credits.RemainingCredit != 8500 {
+		t.Errorf("Unexpected credits usage: %+v", credits)
+	}
+	if status := client.GetLimiterStatus(EndpointUtilsV1Credits); status[0].Limit != 100 {
+		t.Errorf("Expected historical limiter, got %+v", status)
+	}
+}
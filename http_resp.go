@@ -50,6 +50,32 @@ const (
 	TypeSwapTo   TypeSwap = "to"
 )
 
+// ============================================================================
+// Response Metadata
+// ============================================================================
+
+// Pagination represents the pagination object of a paginated response
+type Pagination struct {
+	Limit  int64 `json:"limit" bson:"limit"`
+	Offset int64 `json:"offset" bson:"offset"`
+	Total  int64 `json:"total" bson:"total"`
+}
+
+// ResponseMeta holds the metadata returned next to the data of a response.
+// It is embedded in paginated responses; methods returning a slice have a
+// ...WithMeta variant returning it in a RespPage, or report it through
+// WithResponseMeta.
+type ResponseMeta struct {
+	// Pagination is nil if the response has no pagination object
+	Pagination *Pagination `json:"pagination,omitempty" bson:"pagination,omitempty"`
+}
+
+// RespPage represents the items of a slice response with the metadata of the response
+type RespPage[T any] struct {
+	Items        []T `json:"items" bson:"items"`
+	ResponseMeta `bson:",inline"`
+}
+
 // ============================================================================
 // Time Interval Constants
 // ============================================================================
@@ -183,6 +209,8 @@ type RespTokenTxsItem struct {
 type RespTokenTxs struct {
 	Items   []RespTokenTxsItem `json:"items" bson:"items"`
 	HasNext bool               `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // ============================================================================
@@ -228,18 +256,24 @@ type RespPairTxsItem struct {
 type RespPairTxs struct {
 	Items   []RespPairTxsItem `json:"items" bson:"items"`
 	HasNext bool              `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespTokenTxsByTime represents token transactions by time
 type RespTokenTxsByTime struct {
 	Items   []RespTokenTxsItem `json:"items" bson:"items"`
 	HasNext bool               `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespPairTxsByTime represents pair transactions by time
 type RespPairTxsByTime struct {
 	Items   []RespPairTxsItem `json:"items" bson:"items"`
 	HasNext bool              `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // ============================================================================
@@ -283,6 +317,8 @@ type RespAllTxsItemV3 struct {
 type RespAllTxsV3 struct {
 	Items   []RespAllTxsItemV3 `json:"items" bson:"items"`
 	HasNext bool               `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // TokenInfo represents token information in a V3 transaction
@@ -334,6 +370,8 @@ type RespTokenTxsItemV3 struct {
 type RespTokenTxsV3 struct {
 	Items   []RespTokenTxsItemV3 `json:"items" bson:"items"`
 	HasNext bool                 `json:"has_next" bson:"has_next"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespRecentTxsTokenV3 represents token details in a recent transactions v3 response
@@ -373,6 +411,8 @@ type RespRecentTxsItemV3 struct {
 type RespRecentTxsV3 struct {
 	Items   []RespRecentTxsItemV3 `json:"items" bson:"items"`
 	HasNext bool                  `json:"has_next" bson:"has_next"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // ============================================================================
@@ -629,6 +669,8 @@ type RespTokenListV1 struct {
 	UpdateTime     string                 `json:"updateTime" bson:"updateTime"`
 	Tokens         []RespTokenListV1Token `json:"tokens" bson:"tokens"`
 	Total          int64                  `json:"total" bson:"total"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // TokenExtensions represents token extension metadata
@@ -699,12 +741,26 @@ type RespTokenListV3TokenItem struct {
 type RespTokenListV3 struct {
 	Items   []RespTokenListV3TokenItem `json:"items" bson:"items"`
 	HasNext bool                       `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespTokenListV3Scroll represents token list v3 scroll response
 type RespTokenListV3Scroll struct {
-	Items   []RespTokenListV3TokenItem `json:"items" bson:"items"`
-	HasNext bool                       `json:"hasNext" bson:"hasNext"`
+	Items        []RespTokenListV3TokenItem `json:"items" bson:"items"`
+	HasNext      bool                       `json:"hasNext" bson:"hasNext"`
+	ScrollID     string                     `json:"scroll_id" bson:"scroll_id"`
+	NextScrollID string                     `json:"next_scroll_id" bson:"next_scroll_id"`
+
+	ResponseMeta `bson:",inline"`
+}
+
+// NextCursor returns the scroll ID to request the next page with, empty if there is none.
+func (r RespTokenListV3Scroll) NextCursor() string {
+	if r.NextScrollID != "" {
+		return r.NextScrollID
+	}
+	return r.ScrollID
 }
 
 // ============================================================================
@@ -1524,6 +1580,8 @@ type RespWalletTradesItem struct {
 type RespWalletTrades struct {
 	Items   []RespWalletTradesItem `json:"items" bson:"items"`
 	HasNext bool                   `json:"hasNext" bson:"hasNext"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // ============================================================================
@@ -1661,6 +1719,8 @@ type RespMemeListItem struct {
 type RespMemeList struct {
 	Items   []RespMemeListItem `json:"items" bson:"items"`
 	HasNext bool               `json:"has_next" bson:"has_next"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespMemeDetail represents response type for meme token detail
@@ -1742,11 +1802,7 @@ type RespWalletNetWorthItem struct {
 }
 
 // RespWalletNetWorthPagination represents pagination details
-type RespWalletNetWorthPagination struct {
-	Limit  int64 `json:"limit" bson:"limit"`
-	Offset int64 `json:"offset" bson:"offset"`
-	Total  int64 `json:"total" bson:"total"`
-}
+type RespWalletNetWorthPagination = Pagination
 
 // RespWalletNetWorth represents wallet net worth endpoint response
 type RespWalletNetWorth struct {
@@ -1987,6 +2043,8 @@ type RespTokenAllMarketListItem struct {
 type RespTokenAllMarketList struct {
 	Items []RespTokenAllMarketListItem `json:"items" bson:"items"`
 	Total int64                        `json:"total" bson:"total"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespTrendingToken represents individual trending token details
@@ -2012,6 +2070,8 @@ type RespTokenTrendingList struct {
 	UpdateTime     string              `json:"updateTime" bson:"updateTime"`
 	Tokens         []RespTrendingToken `json:"tokens" bson:"tokens"`
 	Total          int64               `json:"total" bson:"total"`
+
+	ResponseMeta `bson:",inline"`
 }
 
 // RespTokenHolderBatchItem represents token holder batch response item
@@ -2051,3 +2111,18 @@ type RespTokenExitLiquidity struct {
 
 // RespTokensExitLiquidity is a list of exit liquidity items
 type RespTokensExitLiquidity = []RespTokenExitLiquidity
+
+// RespCreditsUsageDetail represents compute unit usage split by API and WebSocket
+type RespCreditsUsageDetail struct {
+	API       float64 `json:"api" bson:"api"`
+	Websocket float64 `json:"websocket" bson:"websocket"`
+	Total     float64 `json:"total" bson:"total"`
+}
+
+// RespCreditsUsage represents the compute unit usage of an API key
+type RespCreditsUsage struct {
+	Usage           RespCreditsUsageDetail `json:"usage" bson:"usage"`
+	OverageUsage    RespCreditsUsageDetail `json:"overage_usage" bson:"overage_usage"`
+	RemainingCredit float64                `json:"remaining_credit" bson:"remaining_credit"`
+	OverageCost     float64                `json:"overage_cost" bson:"overage_cost"`
+}
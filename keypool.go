@@ -0,0 +1,305 @@
+package birdeye
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// API key pool.
+//
+// An HTTPClient configured with several API keys spreads its requests over
+// them. Every key has its own built-in limiters and an optional compute unit
+// budget, so each key's quota is respected separately. Keys that are rejected
+// by the API (401, 402, 403) or run out of budget are benched for a while and
+// the request is retried with another key. Keys without a budget of their own
+// are also benched when a 429 response says their quota is used up; other
+// 429s are left to the limiters and Retry-After.
+
+// ============================================================================
+// Key Configuration
+// ============================================================================
+
+// KeySelection chooses the API key of each request.
+type KeySelection string
+
+const (
+	// KeySelectionLeastLoaded picks the key with the fewest requests waiting or in flight
+	KeySelectionLeastLoaded KeySelection = "least_loaded"
+	// KeySelectionRoundRobin picks the keys in turn
+	KeySelectionRoundRobin KeySelection = "round_robin"
+)
+
+// DefaultKeyBenchDuration is how long a failing key is left out by default.
+const DefaultKeyBenchDuration = time.Minute
+
+// ErrNoAPIKeyAvailable is returned when every API key of the client is benched.
+var ErrNoAPIKeyAvailable = errors.New("no API key available")
+
+// APIKeyConfig configures one key of HTTPClientConfig.APIKeys.
+type APIKeyConfig struct {
+	// Key is the Birdeye API key.
+	// Required field.
+	Key string
+
+	// Name labels the key in APIKeyStatus, e.g. the project owning it.
+	// Optional, default: the masked key
+	Name string
+
+	// RateLimitProfile selects the limits of the key's built-in limiters.
+	// Optional, default: HTTPClientConfig.RateLimitProfile
+	RateLimitProfile *RateLimitProfile
+
+	// ComputeUnitMeter enforces a budget for this key. A key over budget is
+	// benched and the request is sent with another key.
+	// Optional, default: nil (no per-key budget)
+	ComputeUnitMeter *ComputeUnitMeter
+}
+
+// APIKeyStatus describes the health and load of an API key.
+type APIKeyStatus struct {
+	Name         string    // APIKeyConfig.Name or the masked key
+	Healthy      bool      // False while the key is benched
+	BenchedUntil time.Time // Zero unless benched
+	LastError    string    // Latest error of the key, empty if none
+	InFlight     int64     // Requests waiting for a token or in progress
+	Requests     int64     // Attempts sent with the key
+	Failures     int64     // Attempts that failed
+
+	// Usage of the key's budget, nil if it has none
+	Usage *ComputeUnitUsage
+}
+
+// ============================================================================
+// apiKey
+// ============================================================================
+
+// apiKey is an API key of the pool with its limiters.
+type apiKey struct {
+	key              string
+	name             string
+	categoryLimiters map[EndpointCategory]Limiter
+	endpointLimiters map[string]Limiter
+	meter            *ComputeUnitMeter // Budget of the key, may be nil
+	inFlight         atomic.Int64
+
+	mu           sync.Mutex
+	benchedUntil time.Time
+	lastError    string
+	requests     int64
+	failures     int64
+}
+
+// newAPIKey creates a key without limiters.
+func newAPIKey(config APIKeyConfig) *apiKey {
+	name := config.Name
+	if name == "" {
+		name = maskAPIKey(config.Key)
+	}
+	return &apiKey{
+		key:              config.Key,
+		name:             name,
+		categoryLimiters: make(map[EndpointCategory]Limiter),
+		endpointLimiters: make(map[string]Limiter),
+		meter:            config.ComputeUnitMeter,
+	}
+}
+
+// getLimiter gets the appropriate rate limiter for an endpoint
+func (k *apiKey) getLimiter(endpoint string) Limiter {
+	if limiter, ok := k.endpointLimiters[endpoint]; ok {
+		return limiter
+	}
+	return k.categoryLimiters[EndpointCategoryHistorical]
+}
+
+// acquire takes the weight of a call in rate limit tokens from the limiter assigned to the endpoint
+func (k *apiKey) acquire(ctx context.Context, endpoint string, tokens int, behavior RateLimitBehavior) (bool, error) {
+	limiter := k.getLimiter(endpoint)
+	return limiter.Acquire(ctx, capWeight(limiter, tokens), &behavior)
+}
+
+// observeResponse feeds a response back to the endpoint's limiter if it adapts to responses
+func (k *apiKey) observeResponse(endpoint string, resp *http.Response) {
+	if observer, ok := k.getLimiter(endpoint).(ResponseObserver); ok {
+		observer.ObserveResponse(resp.StatusCode, resp.Header)
+	}
+}
+
+// record counts an attempt and its error, if any.
+func (k *apiKey) record(err error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.requests++
+	if err != nil {
+		k.failures++
+		k.lastError = err.Error()
+	}
+}
+
+// refund reverts the budget charge of an attempt that was not billed.
+func (k *apiKey) refund(charge ComputeUnitCharge) {
+	if k.meter != nil && charge.Units > 0 {
+		k.meter.Refund(charge)
+	}
+}
+
+// benched reports whether the key is benched at now.
+func (k *apiKey) benched(now time.Time) bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return now.Before(k.benchedUntil)
+}
+
+// status returns a snapshot of the key's health.
+func (k *apiKey) status(now time.Time) APIKeyStatus {
+	k.mu.Lock()
+	status := APIKeyStatus{
+		Name:      k.name,
+		Healthy:   !now.Before(k.benchedUntil),
+		LastError: k.lastError,
+		InFlight:  k.inFlight.Load(),
+		Requests:  k.requests,
+		Failures:  k.failures,
+	}
+	if !status.Healthy {
+		status.BenchedUntil = k.benchedUntil
+	}
+	k.mu.Unlock()
+
+	if k.meter != nil {
+		usage := k.meter.Usage()
+		status.Usage = &usage
+	}
+	return status
+}
+
+// maskAPIKey hides all but the ends of an API key.
+func maskAPIKey(key string) string {
+	if len(key) <= 8 {
+		return "****"
+	}
+	return key[:4] + "****" + key[len(key)-4:]
+}
+
+// ============================================================================
+// keyPool
+// ============================================================================
+
+// keyPool selects the API key of each request.
+type keyPool struct {
+	keys      []*apiKey
+	selection KeySelection
+	benchFor  time.Duration // 0 disables benching
+	clock     Clock
+	next      atomic.Uint64
+}
+
+// pick selects a healthy key, charges its budget and counts the request as in flight.
+// The caller must decrement inFlight of the key when the attempt is done.
+func (p *keyPool) pick(endpoint string, addresses int) (*apiKey, ComputeUnitCharge, error) {
+	now := p.clock.Now()
+
+	// Rotate the starting key so that ties are broken in turn
+	start := int(p.next.Add(1) - 1)
+	candidates := make([]*apiKey, 0, len(p.keys))
+	for i := range p.keys {
+		if k := p.keys[(start+i)%len(p.keys)]; !k.benched(now) {
+			candidates = append(candidates, k)
+		}
+	}
+	if p.selection != KeySelectionRoundRobin {
+		slices.SortStableFunc(candidates, func(a, b *apiKey) int {
+			return cmp.Compare(a.inFlight.Load(), b.inFlight.Load())
+		})
+	}
+
+	for _, k := range candidates {
+		var charge ComputeUnitCharge
+		if k.meter != nil {
+			var err error
+			if charge, err = k.meter.Charge(endpoint, addresses); err != nil {
+				p.bench(k, err)
+				continue
+			}
+		}
+		k.inFlight.Add(1)
+		return k, charge, nil
+	}
+	return nil, ComputeUnitCharge{}, fmt.Errorf("%w: all %d keys are benched", ErrNoAPIKeyAvailable, len(p.keys))
+}
+
+// bench leaves a key out for the bench duration and reports whether it did.
+func (p *keyPool) bench(k *apiKey, err error) bool {
+	if p.benchFor <= 0 {
+		return false
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.benchedUntil = p.clock.Now().Add(p.benchFor)
+	k.lastError = err.Error()
+	return true
+}
+
+// quotaMessages are fragments of the error messages reporting an exhausted quota
+var quotaMessages = []string{"usage limit exceeded", "quota exceeded", "exceeded your quota", "insufficient compute units", "insufficient credits"}
+
+// isQuotaExhausted reports whether an API error with a quota status (402, 403
+// or 429) says the key's quota is used up.
+func isQuotaExhausted(statusCode int, err error) bool {
+	switch statusCode {
+	case http.StatusPaymentRequired, http.StatusForbidden, http.StatusTooManyRequests:
+	default:
+		return false
+	}
+	var apiErr *BirdeyeAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	msg := strings.ToLower(apiErr.Message)
+	return slices.ContainsFunc(quotaMessages, func(s string) bool { return strings.Contains(msg, s) })
+}
+
+// benchOnStatus benches a key rejected by the API, or whose quota the API
+// reports exhausted, and reports whether it did.
+func (p *keyPool) benchOnStatus(k *apiKey, statusCode int, err error) bool {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusPaymentRequired, http.StatusForbidden:
+		return p.bench(k, err)
+	}
+	if k.meter == nil && isQuotaExhausted(statusCode, err) {
+		return p.bench(k, err)
+	}
+	return false
+}
+
+// primary returns the first key, whose limiters GetLimiterStatus reports.
+func (p *keyPool) primary() *apiKey {
+	return p.keys[0]
+}
+
+// GetAPIKeyStatus returns the health and load of each API key, in configuration order.
+//
+// Example:
+//
+//	for _, s := range client.GetAPIKeyStatus() {
+//	    fmt.Printf("%s healthy=%v in-flight=%d failures=%d %s\n",
+//	        s.Name, s.Healthy, s.InFlight, s.Failures, s.LastError)
+//	}
+func (c *HTTPClient) GetAPIKeyStatus() []APIKeyStatus {
+	now := c.clock.Now()
+	status := make([]APIKeyStatus, len(c.keys.keys))
+	for i, k := range c.keys.keys {
+		status[i] = k.status(now)
+	}
+	return status
+}
@@ -0,0 +1,209 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// requestAPIKey returns the API key a request was sent with.
+func requestAPIKey(r *http.Request) string {
+	return r.Header.Get("X-API-KEY")
+}
+
+func okResponse(w http.ResponseWriter, _ *http.Request) {
+	w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+}
+
+func TestAPIKeyRoundRobin(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys:      []APIKeyConfig{{Key: "key-a"}, {Key: "key-b"}, {Key: "key-c"}},
+		KeySelection: KeySelectionRoundRobin,
+	}, okResponse)
+
+	for range 6 {
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, key := range []string{"key-a", "key-b", "key-c"} {
+		if rec.countBy(requestAPIKey)[key] != 2 {
+			t.Fatalf("Expected 2 calls per key, got %v", rec.countBy(requestAPIKey))
+		}
+	}
+}
+
+func TestAPIKeyLeastLoaded(t *testing.T) {
+	release := make(chan struct{})
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys: []APIKeyConfig{{Key: "slow-key"}, {Key: "fast-key"}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if requestAPIKey(r) == "slow-key" {
+			<-release
+		}
+		okResponse(w, r)
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+		done <- err
+	}()
+	for client.GetAPIKeyStatus()[0].InFlight == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The busy key is avoided while its request is in flight
+	for range 3 {
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if c := rec.countBy(requestAPIKey); c["slow-key"] != 1 || c["fast-key"] != 3 {
+		t.Fatalf("Expected 1 slow and 3 fast calls, got %v", c)
+	}
+}
+
+func TestAPIKeyBench(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys:          []APIKeyConfig{{Key: "revoked-key", Name: "project-a"}, {Key: "valid-key"}},
+		KeySelection:     KeySelectionRoundRobin,
+		KeyBenchDuration: time.Minute,
+		Clock:            clock,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if requestAPIKey(r) == "revoked-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"message":"Unauthorized"}`))
+			return
+		}
+		okResponse(w, r)
+	})
+
+	for range 4 {
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c := rec.countBy(requestAPIKey); c["revoked-key"] != 1 || c["valid-key"] != 4 {
+		t.Fatalf("Expected the revoked key to be used once, got %v", c)
+	}
+
+	status := client.GetAPIKeyStatus()
+	if status[0].Name != "project-a" || status[0].Healthy || status[0].LastError == "" || status[0].Failures != 1 {
+		t.Fatalf("Expected project-a to be benched, got %+v", status[0])
+	}
+	if status[1].Name != "vali****-key" || !status[1].Healthy || status[1].Requests != 4 {
+		t.Fatalf("Expected a healthy masked key, got %+v", status[1])
+	}
+
+	clock.Advance(time.Minute)
+	if !client.GetAPIKeyStatus()[0].Healthy {
+		t.Fatal("Expected the key to recover after the bench duration")
+	}
+}
+
+func TestAPIKeyBudget(t *testing.T) {
+	budget := NewComputeUnitMeter(ComputeUnitConfig{DailyBudget: int64(DefaultEndpointCosts[EndpointDefiPrice].Units)})
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys:      []APIKeyConfig{{Key: "key-a", ComputeUnitMeter: budget}, {Key: "key-b"}},
+		KeySelection: KeySelectionRoundRobin,
+	}, okResponse)
+
+	for range 3 {
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c := rec.countBy(requestAPIKey); c["key-a"] != 1 || c["key-b"] != 2 {
+		t.Fatalf("Expected key-a to stop at its budget, got %v", c)
+	}
+	status := client.GetAPIKeyStatus()[0]
+	if status.Healthy || status.Usage == nil || status.Usage.Daily != budget.Usage().DailyBudget {
+		t.Fatalf("Expected key-a benched with its budget used, got %+v", status)
+	}
+}
+
+func TestAPIKeyAllBenched(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys: []APIKeyConfig{{Key: "only-key"}},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"success":false,"message":"Forbidden"}`))
+	})
+
+	_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+	if !errors.Is(err, ErrNoAPIKeyAvailable) {
+		t.Fatalf("Expected ErrNoAPIKeyAvailable, got %v", err)
+	}
+	var apiErr *BirdeyeAPIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("Expected the 403 to be reported, got %v", err)
+	}
+	if rec.countBy(requestAPIKey)["only-key"] != 1 {
+		t.Fatalf("Expected 1 call, got %v", rec.countBy(requestAPIKey))
+	}
+}
+
+func TestAPIKeyQuotaBench(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		APIKeys:      []APIKeyConfig{{Key: "spent-key"}, {Key: "valid-key"}},
+		KeySelection: KeySelectionRoundRobin,
+		RetryPolicy:  &RetryPolicy{MaxAttempts: 2},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		if requestAPIKey(r) == "spent-key" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"message":"Compute units usage limit exceeded"}`))
+			return
+		}
+		okResponse(w, r)
+	})
+
+	for range 3 {
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c := rec.countBy(requestAPIKey); c["spent-key"] != 1 || c["valid-key"] != 3 {
+		t.Fatalf("Expected the spent key to be used once, got %v", c)
+	}
+	if s := client.GetAPIKeyStatus()[0]; s.Healthy || s.LastError == "" {
+		t.Fatalf("Expected the spent key to be benched, got %+v", s)
+	}
+}
+
+func TestAPIKeyNoQuotaBench(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		message string
+		keys    []APIKeyConfig
+	}{
+		{"rate limited", http.StatusTooManyRequests, "Too many requests", nil},
+		{"validation error", http.StatusBadRequest, "credit_type is invalid", nil},
+		{"server error", http.StatusInternalServerError, "quota exceeded upstream", nil},
+		{"metered key", http.StatusTooManyRequests, "Compute units usage limit exceeded",
+			[]APIKeyConfig{{Key: "metered-key", ComputeUnitMeter: NewComputeUnitMeter(ComputeUnitConfig{})}}},
+	}
+	for _, tt := range tests {
+		client, _ := newRecordingClient(t, HTTPClientConfig{
+			APIKeys:     tt.keys,
+			RetryPolicy: &RetryPolicy{MaxAttempts: 1},
+		}, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			w.Write([]byte(`{"success":false,"message":"` + tt.message + `"}`))
+		})
+		if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err == nil {
+			t.Fatalf("%s: expected an error", tt.name)
+		}
+		if s := client.GetAPIKeyStatus()[0]; !s.Healthy {
+			t.Errorf("%s: expected the key to stay healthy, got %+v", tt.name, s)
+		}
+	}
+}
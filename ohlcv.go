@@ -0,0 +1,328 @@
+package birdeye
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Chunked OHLCV range fetching.
+//
+// Birdeye caps the number of candles in one OHLCV response. The *Range
+// methods split a long window into chunks of at most that many candles,
+// request them concurrently through the rate limiters, and stitch the
+// responses into one series sorted by UnixTime, without duplicates. Periods
+// without candles are reported as gaps.
+
+// ============================================================================
+// Range Configuration
+// ============================================================================
+
+const (
+	// MaxOHLCVCandles is the number of candles requested per chunk from the v1
+	// OHLCV endpoints (GetTokenOHLCV, GetPairOHLCV, GetOHLCVBaseQuote).
+	MaxOHLCVCandles = 1000
+
+	// MaxOHLCVCandlesV3 is the number of candles requested per chunk from the
+	// v3 OHLCV endpoints (GetTokenOHLCVV3, GetPairOHLCVV3).
+	MaxOHLCVCandlesV3 = 5000
+
+	// DefaultOHLCVRangeConcurrency is the default number of chunks requested at once.
+	DefaultOHLCVRangeConcurrency = 4
+)
+
+// OHLCVRange is a candle series stitched from chunked requests.
+type OHLCVRange[T any] struct {
+	// Items holds the candles sorted by UnixTime, one per time
+	Items []T
+	// Gaps lists the periods of the requested window without candles
+	Gaps []OHLCVGap
+	// Chunks is the number of requests the window was split into
+	Chunks int
+}
+
+// OHLCVGap is a period without candles. From and To are the start times of
+// the first and last missing candle, or the bounds of the requested window.
+type OHLCVGap struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+// ============================================================================
+// Candle Steps
+// ============================================================================
+
+// ohlcvStep is the length of the candles of an interval.
+type ohlcvStep struct {
+	seconds int64
+	monthly bool // Calendar months; seconds is the longest month
+}
+
+// ohlcvSteps holds the candle length of each supported interval.
+var ohlcvSteps = map[TimeInterval]ohlcvStep{
+	Interval1s:  {seconds: 1},
+	Interval15s: {seconds: 15},
+	Interval1m:  {seconds: 60},
+	Interval3m:  {seconds: 3 * 60},
+	Interval5m:  {seconds: 5 * 60},
+	Interval15m: {seconds: 15 * 60},
+	Interval30m: {seconds: 30 * 60},
+	Interval1H:  {seconds: 3600},
+	Interval2H:  {seconds: 2 * 3600},
+	Interval4H:  {seconds: 4 * 3600},
+	Interval6H:  {seconds: 6 * 3600},
+	Interval8H:  {seconds: 8 * 3600},
+	Interval12H: {seconds: 12 * 3600},
+	Interval1D:  {seconds: 86400},
+	Interval3D:  {seconds: 3 * 86400},
+	Interval1W:  {seconds: 7 * 86400},
+	Interval1M:  {seconds: 31 * 86400, monthly: true},
+}
+
+// next returns the start time of the candle after the one starting at t.
+func (s ohlcvStep) next(t int64) int64 {
+	if s.monthly {
+		return time.Unix(t, 0).UTC().AddDate(0, 1, 0).Unix()
+	}
+	return t + s.seconds
+}
+
+// prev returns the start time of the candle before the one starting at t.
+func (s ohlcvStep) prev(t int64) int64 {
+	if s.monthly {
+		return time.Unix(t, 0).UTC().AddDate(0, -1, 0).Unix()
+	}
+	return t - s.seconds
+}
+
+// ohlcvGaps returns the periods of [timeFrom, timeTo] without candles, given
+// the sorted start times of the candles received.
+func ohlcvGaps(times []int64, timeFrom, timeTo int64, step ohlcvStep) []OHLCVGap {
+	if len(times) == 0 {
+		return []OHLCVGap{{From: timeFrom, To: timeTo}}
+	}
+
+	var gaps []OHLCVGap
+	if before := step.prev(times[0]); before >= timeFrom {
+		gaps = append(gaps, OHLCVGap{From: timeFrom, To: before})
+	}
+	for i := 1; i < len(times); i++ {
+		if missing := step.next(times[i-1]); missing < times[i] {
+			gaps = append(gaps, OHLCVGap{From: missing, To: step.prev(times[i])})
+		}
+	}
+	if after := step.next(times[len(times)-1]); after <= timeTo {
+		gaps = append(gaps, OHLCVGap{From: after, To: timeTo})
+	}
+	return gaps
+}
+
+// ============================================================================
+// Range Fetcher
+// ============================================================================
+
+// fetchOHLCVRange splits [timeFrom, timeTo] into chunks of at most
+// maxCandles candles, fetches them concurrently and merges the results.
+//
+// fetch requests the candles of one chunk, with inclusive bounds. unixTime
+// returns the start time of a candle.
+func fetchOHLCVRange[T any](
+	ctx context.Context,
+	c *HTTPClient,
+	intervalType string,
+	timeFrom, timeTo, maxCandles int64,
+	fetch func(ctx context.Context, from, to int64) ([]T, error),
+	unixTime func(T) int64,
+) (*OHLCVRange[T], error) {
+	step, ok := ohlcvSteps[TimeInterval(intervalType)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported OHLCV interval %q", intervalType)
+	}
+	if timeFrom < 0 || timeTo > 10000000000 || timeFrom > timeTo {
+		return nil, errors.New("time range must satisfy 0 <= time_from <= time_to <= 10000000000")
+	}
+
+	// Chunk bounds
+	span := step.seconds * maxCandles
+	var bounds [][2]int64
+	for from := timeFrom; from <= timeTo; from += span {
+		to := from + span - 1
+		if to > timeTo {
+			to = timeTo
+		}
+		bounds = append(bounds, [2]int64{from, to})
+	}
+
+	// Fetch the chunks, stopping at the first error
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	results := make([][]T, len(bounds))
+	workers := make(chan struct{}, c.rangeWorkers)
+	var wg sync.WaitGroup
+	for i, b := range bounds {
+		select {
+		case workers <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Go(func() {
+			defer func() { <-workers }()
+			var skipped bool
+			items, err := fetch(withSkipReport(ctx, &skipped), b[0], b[1])
+			if err == nil && skipped {
+				err = fmt.Errorf("%w: chunk was skipped", ErrRateLimitExceeded)
+			}
+			if err != nil {
+				cancel(fmt.Errorf("OHLCV chunk %d-%d: %w", b[0], b[1], err))
+				return
+			}
+			results[i] = items
+		})
+	}
+	wg.Wait()
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+
+	// Merge the candles overlapping the window, sorted and deduplicated by start time
+	var items []T
+	for _, chunk := range results {
+		for _, item := range chunk {
+			if t := unixTime(item); step.next(t) > timeFrom && t <= timeTo {
+				items = append(items, item)
+			}
+		}
+	}
+	slices.SortStableFunc(items, func(a, b T) int { return cmp.Compare(unixTime(a), unixTime(b)) })
+	items = slices.CompactFunc(items, func(a, b T) bool { return unixTime(a) == unixTime(b) })
+
+	times := make([]int64, len(items))
+	for i, item := range items {
+		times[i] = unixTime(item)
+	}
+	return &OHLCVRange[T]{
+		Items:  items,
+		Gaps:   ohlcvGaps(times, timeFrom, timeTo, step),
+		Chunks: len(bounds),
+	}, nil
+}
+
+// copyOptions returns a copy of opts, so that concurrent requests do not
+// share the struct their defaults are applied to.
+func copyOptions[O any](opts *O) *O {
+	var o O
+	if opts != nil {
+		o = *opts
+	}
+	return &o
+}
+
+// ============================================================================
+// OHLCV Range Methods
+// ============================================================================
+
+// GetTokenOHLCVRange retrieves the complete OHLCV series of a token over
+// [timeFrom, timeTo] through GetTokenOHLCV, however many candles it holds.
+//
+// The window is split into chunks of MaxOHLCVCandles candles, requested
+// HTTPClientConfig.OHLCVRangeConcurrency at a time. The first failing chunk
+// fails the whole call, as does a chunk skipped by the rate limiter, with an
+// error matching ErrRateLimitExceeded; Gaps only hold periods without candles.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeout control
+//   - address: Token contract address to query
+//   - intervalType: Time interval, as for GetTokenOHLCV
+//   - timeFrom, timeTo: Window in Unix timestamps (seconds), inclusive
+//   - opts: Options applied to every chunk (see TokenOHLCVOptions)
+//
+// Returns:
+//   - *OHLCVRange[RespTokenOHLCVItem]: Candles sorted by UnixTime, and the gaps between them
+//   - error: Error if the interval is unsupported, the window is invalid or a chunk fails
+//
+// Example:
+//
+//	// A year of 1-minute candles
+//	to := time.Now().Unix()
+//	series, err := client.GetTokenOHLCVRange(ctx, tokenAddress, "1m", to-365*86400, to, nil)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, gap := range series.Gaps {
+//	    log.Printf("no candles from %d to %d", gap.From, gap.To)
+//	}
+func (c *HTTPClient) GetTokenOHLCVRange(ctx context.Context, address, intervalType string, timeFrom, timeTo int64, opts *TokenOHLCVOptions) (*OHLCVRange[RespTokenOHLCVItem], error) {
+	return fetchOHLCVRange(ctx, c, intervalType, timeFrom, timeTo, MaxOHLCVCandles,
+		func(ctx context.Context, from, to int64) ([]RespTokenOHLCVItem, error) {
+			resp, err := c.GetTokenOHLCV(ctx, address, intervalType, from, to, copyOptions(opts))
+			if err != nil || resp == nil {
+				return nil, err
+			}
+			return resp.Items, nil
+		},
+		func(candle RespTokenOHLCVItem) int64 { return candle.UnixTime })
+}
+
+// GetTokenOHLCVV3Range retrieves the complete OHLCV series of a token through
+// GetTokenOHLCVV3, in chunks of MaxOHLCVCandlesV3 candles, like
+// GetTokenOHLCVRange. opts.Mode and opts.CountLimit are ignored.
+func (c *HTTPClient) GetTokenOHLCVV3Range(ctx context.Context, address, intervalType string, timeFrom, timeTo int64, opts *TokenOHLCVV3Options) (*OHLCVRange[RespTokenOHLCVItemV3], error) {
+	return fetchOHLCVRange(ctx, c, intervalType, timeFrom, timeTo, MaxOHLCVCandlesV3,
+		func(ctx context.Context, from, to int64) ([]RespTokenOHLCVItemV3, error) {
+			o := copyOptions(opts)
+			o.Mode = "range"
+			o.CountLimit = MaxOHLCVCandlesV3
+			resp, err := c.GetTokenOHLCVV3(ctx, address, intervalType, from, to, o)
+			if err != nil || resp == nil {
+				return nil, err
+			}
+			return resp.Items, nil
+		},
+		func(candle RespTokenOHLCVItemV3) int64 { return candle.UnixTime })
+}
+
+// GetPairOHLCVRange retrieves the complete OHLCV series of a trading pair
+// through GetPairOHLCV, in chunks of MaxOHLCVCandles candles, like
+// GetTokenOHLCVRange.
+func (c *HTTPClient) GetPairOHLCVRange(ctx context.Context, address, intervalType string, timeFrom, timeTo int64, opts *PairOHLCVOptions) (*OHLCVRange[RespPairOHLCVItem], error) {
+	return fetchOHLCVRange(ctx, c, intervalType, timeFrom, timeTo, MaxOHLCVCandles,
+		func(ctx context.Context, from, to int64) ([]RespPairOHLCVItem, error) {
+			return c.GetPairOHLCV(ctx, address, intervalType, from, to, copyOptions(opts))
+		},
+		func(candle RespPairOHLCVItem) int64 { return candle.UnixTime })
+}
+
+// GetPairOHLCVV3Range retrieves the complete OHLCV series of a trading pair
+// through GetPairOHLCVV3, in chunks of MaxOHLCVCandlesV3 candles, like
+// GetTokenOHLCVRange. opts.Mode and opts.CountLimit are ignored.
+func (c *HTTPClient) GetPairOHLCVV3Range(ctx context.Context, address, intervalType string, timeFrom, timeTo int64, opts *TokenOHLCVV3Options) (*OHLCVRange[RespPairOHLCVItemV3], error) {
+	return fetchOHLCVRange(ctx, c, intervalType, timeFrom, timeTo, MaxOHLCVCandlesV3,
+		func(ctx context.Context, from, to int64) ([]RespPairOHLCVItemV3, error) {
+			o := copyOptions(opts)
+			o.Mode = "range"
+			o.CountLimit = MaxOHLCVCandlesV3
+			return c.GetPairOHLCVV3(ctx, address, intervalType, from, to, o)
+		},
+		func(candle RespPairOHLCVItemV3) int64 { return candle.UnixTime })
+}
+
+// GetOHLCVBaseQuoteRange retrieves the complete OHLCV series of a base/quote
+// token pair through GetOHLCVBaseQuote, in chunks of MaxOHLCVCandles candles,
+// like GetTokenOHLCVRange.
+func (c *HTTPClient) GetOHLCVBaseQuoteRange(ctx context.Context, baseAddress, quoteAddress, intervalType string, timeFrom, timeTo int64, opts *OHLCVBaseQuoteOptions) (*OHLCVRange[RespOHLCVBaseQuoteItem], error) {
+	return fetchOHLCVRange(ctx, c, intervalType, timeFrom, timeTo, MaxOHLCVCandles,
+		func(ctx context.Context, from, to int64) ([]RespOHLCVBaseQuoteItem, error) {
+			resp, err := c.GetOHLCVBaseQuote(ctx, baseAddress, quoteAddress, intervalType, from, to, copyOptions(opts))
+			if err != nil || resp == nil {
+				return nil, err
+			}
+			return resp.Items, nil
+		},
+		func(candle RespOHLCVBaseQuoteItem) int64 { return candle.UnixTime })
+}
@@ -0,0 +1,171 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// ohlcvHandler serves 1-minute candles for every minute of the requested
+// window except the missing ones, plus the candle before the window so that
+// chunks overlap. peak records the highest number of concurrent requests.
+func ohlcvHandler(missing map[int64]bool, peak *atomic.Int64) http.HandlerFunc {
+	var inFlight atomic.Int64
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		from, _ := strconv.ParseInt(r.URL.Query().Get("time_from"), 10, 64)
+		to, _ := strconv.ParseInt(r.URL.Query().Get("time_to"), 10, 64)
+		items := []map[string]any{}
+		for ts := (from/60 - 1) * 60; ts <= to; ts += 60 {
+			if ts < 0 || missing[ts] {
+				continue
+			}
+			items = append(items, map[string]any{"unixTime": ts, "unix_time": ts, "c": float64(ts)})
+		}
+		// Newest first, as some endpoints return
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+		json.NewEncoder(w).Encode(map[string]any{"success": true, "data": map[string]any{"items": items}})
+	}
+}
+
+func TestGetTokenOHLCVRange(t *testing.T) {
+	missing := map[int64]bool{600: true, 660: true, 60000: true, 60060: true}
+	var peak atomic.Int64
+	client, rec := newRecordingClient(t, HTTPClientConfig{OHLCVRangeConcurrency: 2}, ohlcvHandler(missing, &peak))
+
+	from, to := int64(0), int64(3500*60)
+	series, err := client.GetTokenOHLCVRange(context.Background(), testTokenSOL, "1m", from, to+30, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if series.Chunks != 4 {
+		t.Errorf("expected 4 chunks, got %d", series.Chunks)
+	}
+	if want := 3501 - len(missing); len(series.Items) != want {
+		t.Fatalf("expected %d candles, got %d", want, len(series.Items))
+	}
+	for i := 1; i < len(series.Items); i++ {
+		if series.Items[i].UnixTime <= series.Items[i-1].UnixTime {
+			t.Fatalf("candles not sorted and unique at %d: %d after %d", i, series.Items[i].UnixTime, series.Items[i-1].UnixTime)
+		}
+	}
+	// 60000 and 60060 straddle the boundary of the first two chunks
+	wantGaps := []OHLCVGap{{From: 600, To: 660}, {From: 60000, To: 60060}}
+	if len(series.Gaps) != len(wantGaps) || series.Gaps[0] != wantGaps[0] || series.Gaps[1] != wantGaps[1] {
+		t.Errorf("gaps %v, want %v", series.Gaps, wantGaps)
+	}
+
+	if n := rec.count(); n != 4 {
+		t.Errorf("expected 4 requests, got %d", n)
+	}
+	if n := peak.Load(); n > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", n)
+	}
+}
+
+func TestGetPairOHLCVV3Range(t *testing.T) {
+	client, _ := newRecordingClient(t, HTTPClientConfig{}, ohlcvHandler(nil, new(atomic.Int64)))
+
+	// The window starts after the first candle and ends before the last
+	series, err := client.GetPairOHLCVV3Range(context.Background(), testPairAddress, "1m", 90, 12*3600, &TokenOHLCVV3Options{Mode: "count"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if series.Chunks != 1 || len(series.Items) != 720 || series.Items[0].UnixTime != 60 {
+		t.Errorf("unexpected series: %d chunks, %d candles", series.Chunks, len(series.Items))
+	}
+	if len(series.Gaps) != 0 {
+		t.Errorf("unexpected gaps %v", series.Gaps)
+	}
+}
+
+func TestOHLCVRangeErrors(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"success":false,"message":"invalid address"}`))
+	})
+	ctx := context.Background()
+
+	if _, err := client.GetTokenOHLCVRange(ctx, testTokenSOL, "7m", 0, 3600, nil); err == nil {
+		t.Error("expected an error for an unsupported interval")
+	}
+	if _, err := client.GetTokenOHLCVRange(ctx, testTokenSOL, "1m", 3600, 0, nil); err == nil {
+		t.Error("expected an error for an inverted window")
+	}
+	if rec.count() != 0 {
+		t.Errorf("invalid arguments made %d requests", rec.count())
+	}
+
+	_, err := client.GetOHLCVBaseQuoteRange(ctx, testTokenSOL, testTokenUSDC, "1m", 0, 100000*60, nil)
+	var apiErr *BirdeyeAPIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected the chunk's API error, got %v", err)
+	}
+	if n := rec.count(); n >= 100 {
+		t.Errorf("expected the failure to stop the remaining chunks, got %d requests", n)
+	}
+}
+
+func TestOHLCVGaps(t *testing.T) {
+	day := int64(86400)
+	month := ohlcvSteps[Interval1M]
+	jan, mar, apr := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC).Unix(), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC).Unix()
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+	tests := []struct {
+		name         string
+		times        []int64
+		timeFrom, to int64
+		step         ohlcvStep
+		want         []OHLCVGap
+	}{
+		{"empty", nil, 0, 10 * day, ohlcvSteps[Interval1D], []OHLCVGap{{0, 10 * day}}},
+		{"complete", []int64{0, day, 2 * day}, 0, 2*day + 10, ohlcvSteps[Interval1D], nil},
+		{"edges", []int64{2 * day, 3 * day}, 0, 6 * day, ohlcvSteps[Interval1D], []OHLCVGap{{0, day}, {4 * day, 6 * day}}},
+		{"months", []int64{jan, mar, apr}, jan, apr, month, []OHLCVGap{{feb, feb}}},
+	}
+	for _, tt := range tests {
+		got := ohlcvGaps(tt.times, tt.timeFrom, tt.to, tt.step)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: gaps %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: gaps %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestOHLCVRangeSkipped(t *testing.T) {
+	config := skippingConfig(t, EndpointDefiOHLCV, 2)
+	config.OHLCVRangeConcurrency = 1
+	client, rec := newRecordingClient(t, config, ohlcvHandler(nil, new(atomic.Int64)))
+
+	series, err := client.GetTokenOHLCVRange(context.Background(), testTokenSOL, "1m", 0, 3500*60, nil)
+	if !errors.Is(err, ErrRateLimitExceeded) || series != nil {
+		t.Fatalf("expected ErrRateLimitExceeded, got %v", err)
+	}
+	if n := rec.count(); n != 2 {
+		t.Errorf("expected 2 requests, got %d", n)
+	}
+}
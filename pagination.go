@@ -0,0 +1,313 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// Pagination iterators.
+//
+// Offset-paginated endpoints take Offset and Limit options. The *All methods
+// below page through them automatically and yield their items one by one:
+//
+//	for tx, err := range client.TokenTxsV3All(ctx, address, nil) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    process(tx)
+//	}
+//
+// Paging starts at opts.Offset, requests opts.Limit items per page and stops
+// after the last page, at the pagination window or when the loop breaks.
+// An error is yielded once and ends the iteration; a page skipped with
+// RateLimitSkip yields ErrRateLimitExceeded, so that a truncated iteration is
+// not taken for a complete one.
+
+// ============================================================================
+// Pagination Window
+// ============================================================================
+
+// MaxPaginationWindow is the largest offset + limit accepted by Birdeye's
+// offset-paginated endpoints, used for endpoints without a window of their
+// own in HTTPClientConfig.PaginationWindows.
+const MaxPaginationWindow = 10000
+
+// ErrPaginationWindow is yielded by the walkers when more transactions share
+// one time or block number than fit in the pagination window. The ones beyond
+// the window cannot be requested and are skipped.
+var ErrPaginationWindow = errors.New("items beyond the pagination window")
+
+// paginationWindow returns the largest offset + limit requested from an endpoint.
+func (c *HTTPClient) paginationWindow(endpoint string) int64 {
+	if window, ok := c.windows[endpoint]; ok && window > 0 {
+		return window
+	}
+	return MaxPaginationWindow
+}
+
+// paginate iterates over the items of consecutive pages, up to offset + limit
+// = window.
+//
+// The options are copied and their defaults applied, so that the iterator
+// can be ranged over several times. cursor returns the offset and limit fields
+// of the copy; fetch requests the page they select with the given context and
+// reports whether another page follows. A page skipped by the rate limiter
+// yields an error matching ErrRateLimitExceeded.
+func paginate[O, T any](ctx context.Context, opts *O, window int64, cursor func(*O) (offset, limit *int64), fetch func(context.Context, *O) ([]T, bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var o O
+		if opts != nil {
+			o = *opts
+		}
+		if _, err := ApplyDefaultsAndBuildParams(&o); err != nil {
+			yield(zero, fmt.Errorf("failed to apply defaults: %w", err))
+			return
+		}
+
+		offset, limit := cursor(&o)
+		for *offset < window {
+			if *offset+*limit > window {
+				*limit = window - *offset
+			}
+
+			var skipped bool
+			items, hasNext, err := fetch(withSkipReport(ctx, &skipped), &o)
+			if err == nil && skipped {
+				err = fmt.Errorf("%w: page at offset %d was skipped", ErrRateLimitExceeded, *offset)
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if !hasNext || len(items) == 0 {
+				return
+			}
+			*offset += int64(len(items))
+		}
+	}
+}
+
+// fullPage reports whether a page without a next-page flag may be followed by another.
+func fullPage[T any](items []T, limit int64) bool {
+	return int64(len(items)) >= limit
+}
+
+// ============================================================================
+// Transaction Iterators
+// ============================================================================
+
+// TokenTxsAll iterates over the transactions of a token, paging through GetTokenTxs.
+func (c *HTTPClient) TokenTxsAll(ctx context.Context, address string, opts *TokenTxsOptions) iter.Seq2[RespTokenTxsItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTxsToken), func(o *TokenTxsOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenTxsOptions) ([]RespTokenTxsItem, bool, error) {
+			resp, err := c.GetTokenTxs(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// TokenTxsV3All iterates over the transactions of a token, paging through GetTokenTxsV3.
+//
+// Example:
+//
+//	for tx, err := range client.TokenTxsV3All(ctx, tokenAddress, &birdeye.TokenTxsV3Options{TxType: "swap"}) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(tx.TxHash, tx.VolumeUSD)
+//	}
+func (c *HTTPClient) TokenTxsV3All(ctx context.Context, address string, opts *TokenTxsV3Options) iter.Seq2[RespTokenTxsItemV3, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3TokenTxs), func(o *TokenTxsV3Options) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenTxsV3Options) ([]RespTokenTxsItemV3, bool, error) {
+			resp, err := c.GetTokenTxsV3(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// TokenTxsByTimeAll iterates over the transactions of a token, paging through GetTokenTxsByTime.
+func (c *HTTPClient) TokenTxsByTimeAll(ctx context.Context, address string, opts *TokenTxsByTimeOptions) iter.Seq2[RespTokenTxsItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTxsTokenSeekByTime), func(o *TokenTxsByTimeOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenTxsByTimeOptions) ([]RespTokenTxsItem, bool, error) {
+			resp, err := c.GetTokenTxsByTime(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// PairTxsAll iterates over the transactions of a pair, paging through GetPairTxs.
+func (c *HTTPClient) PairTxsAll(ctx context.Context, address string, opts *PairTxsOptions) iter.Seq2[RespPairTxsItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTxsPair), func(o *PairTxsOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *PairTxsOptions) ([]RespPairTxsItem, bool, error) {
+			resp, err := c.GetPairTxs(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// PairTxsByTimeAll iterates over the transactions of a pair, paging through GetPairTxsByTime.
+func (c *HTTPClient) PairTxsByTimeAll(ctx context.Context, address string, opts *PairTxsByTimeOptions) iter.Seq2[RespPairTxsItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTxsPairSeekByTime), func(o *PairTxsByTimeOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *PairTxsByTimeOptions) ([]RespPairTxsItem, bool, error) {
+			resp, err := c.GetPairTxsByTime(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// RecentTxsAll iterates over the latest transactions, paging through GetRecentTxs.
+func (c *HTTPClient) RecentTxsAll(ctx context.Context, opts *RecentTxsV3Options) iter.Seq2[RespRecentTxsItemV3, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3Txs), func(o *RecentTxsV3Options) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *RecentTxsV3Options) ([]RespRecentTxsItemV3, bool, error) {
+			resp, err := c.GetRecentTxs(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// TokenMintBurnTxsAll iterates over the mint and burn transactions of a token,
+// paging through GetTokenMintBurnTxs.
+func (c *HTTPClient) TokenMintBurnTxsAll(ctx context.Context, address string, opts *TokenMintBurnTxsOptions) iter.Seq2[RespTokenMintBurnTxItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3TokenMintBurnTxs), func(o *TokenMintBurnTxsOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenMintBurnTxsOptions) ([]RespTokenMintBurnTxItem, bool, error) {
+			items, err := c.GetTokenMintBurnTxs(ctx, address, o)
+			return items, fullPage(items, o.Limit), err
+		})
+}
+
+// ============================================================================
+// Token Iterators
+// ============================================================================
+
+// TokenHoldersAll iterates over the holders of a token, paging through GetTokenHolders.
+func (c *HTTPClient) TokenHoldersAll(ctx context.Context, address string, opts *TokenHoldersOptions) iter.Seq2[RespTokenHoldersItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3TokenHolder), func(o *TokenHoldersOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenHoldersOptions) ([]RespTokenHoldersItem, bool, error) {
+			items, err := c.GetTokenHolders(ctx, address, o)
+			return items, fullPage(items, o.Limit), err
+		})
+}
+
+// TokenTopTradersAll iterates over the top traders of a token, paging through GetTokenTopTraders.
+func (c *HTTPClient) TokenTopTradersAll(ctx context.Context, address string, opts *TokenTopTradersOptions) iter.Seq2[RespTokenTopTraderItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV2TokensTopTraders), func(o *TokenTopTradersOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenTopTradersOptions) ([]RespTokenTopTraderItem, bool, error) {
+			items, err := c.GetTokenTopTraders(ctx, address, o)
+			return items, fullPage(items, o.Limit), err
+		})
+}
+
+// TokenAllMarketListAll iterates over the markets of a token, paging through GetTokenAllMarketList.
+func (c *HTTPClient) TokenAllMarketListAll(ctx context.Context, address string, opts *TokenAllMarketListOptions) iter.Seq2[RespTokenAllMarketListItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV2Markets), func(o *TokenAllMarketListOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenAllMarketListOptions) ([]RespTokenAllMarketListItem, bool, error) {
+			resp, err := c.GetTokenAllMarketList(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, o.Offset+int64(len(resp.Items)) < resp.Total, nil
+		})
+}
+
+// TokenListV3All iterates over the token list, paging through GetTokenListV3.
+func (c *HTTPClient) TokenListV3All(ctx context.Context, opts *TokenListV3Options) iter.Seq2[RespTokenListV3TokenItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3TokenList), func(o *TokenListV3Options) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenListV3Options) ([]RespTokenListV3TokenItem, bool, error) {
+			resp, err := c.GetTokenListV3(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// TokenListV1All iterates over the token list, paging through GetTokenListV1.
+func (c *HTTPClient) TokenListV1All(ctx context.Context, opts *TokenListV1Options) iter.Seq2[RespTokenListV1Token, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTokenList), func(o *TokenListV1Options) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TokenListV1Options) ([]RespTokenListV1Token, bool, error) {
+			resp, err := c.GetTokenListV1(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Tokens, o.Offset+int64(len(resp.Tokens)) < resp.Total, nil
+		})
+}
+
+// TokenTrendingListAll iterates over the trending tokens, paging through GetTokenTrendingList.
+func (c *HTTPClient) TokenTrendingListAll(ctx context.Context, opts *TrendingListOptions) iter.Seq2[RespTrendingToken, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiTokenTrending), func(o *TrendingListOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *TrendingListOptions) ([]RespTrendingToken, bool, error) {
+			resp, err := c.GetTokenTrendingList(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Tokens, o.Offset+int64(len(resp.Tokens)) < resp.Total, nil
+		})
+}
+
+// MemeListAll iterates over the meme tokens, paging through GetMemeList.
+func (c *HTTPClient) MemeListAll(ctx context.Context, opts *MemeListOptions) iter.Seq2[RespMemeListItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointDefiV3TokenMemeList), func(o *MemeListOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *MemeListOptions) ([]RespMemeListItem, bool, error) {
+			resp, err := c.GetMemeList(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// GainersLosersAll iterates over the top gainers or losers, paging through GetGainersLosers.
+func (c *HTTPClient) GainersLosersAll(ctx context.Context, opts *GainersLosersOptions) iter.Seq2[RespGainerLoserItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointTraderGainersLosers), func(o *GainersLosersOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *GainersLosersOptions) ([]RespGainerLoserItem, bool, error) {
+			items, err := c.GetGainersLosers(ctx, o)
+			return items, fullPage(items, o.Limit), err
+		})
+}
+
+// ============================================================================
+// Wallet Iterators
+// ============================================================================
+
+// WalletTradesAll iterates over the trades of a wallet, paging through GetWalletTrades.
+func (c *HTTPClient) WalletTradesAll(ctx context.Context, walletAddress string, opts *WalletTradesOptions) iter.Seq2[RespWalletTradesItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointTraderTxsSeekByTime), func(o *WalletTradesOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *WalletTradesOptions) ([]RespWalletTradesItem, bool, error) {
+			resp, err := c.GetWalletTrades(ctx, walletAddress, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		})
+}
+
+// WalletBalanceChangesAll iterates over the balance changes of a wallet,
+// paging through GetWalletBalanceChanges.
+func (c *HTTPClient) WalletBalanceChangesAll(ctx context.Context, wallet, tokenAddress string, opts *WalletBalanceChangesOptions) iter.Seq2[RespWalletBalanceChangesItem, error] {
+	return paginate(ctx, opts, c.paginationWindow(EndpointV1WalletTokenBalance), func(o *WalletBalanceChangesOptions) (*int64, *int64) { return &o.Offset, &o.Limit },
+		func(ctx context.Context, o *WalletBalanceChangesOptions) ([]RespWalletBalanceChangesItem, bool, error) {
+			items, err := c.GetWalletBalanceChanges(ctx, wallet, tokenAddress, o)
+			return items, fullPage(items, o.Limit), err
+		})
+}
@@ -0,0 +1,180 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// pageRequest is the offset and limit of a page request.
+type pageRequest struct {
+	offset, limit int
+}
+
+// pagingHandler serves total numbered token transactions and holders.
+func pagingHandler(total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		items := []map[string]any{}
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, map[string]any{"tx_hash": fmt.Sprintf("tx-%d", i), "owner": fmt.Sprintf("owner-%d", i)})
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    map[string]any{"items": items, "has_next": offset+limit < total},
+		})
+	}
+}
+
+// requestedPages returns the pages requested from a mock server.
+func requestedPages(rec *requestRecorder) []pageRequest {
+	var pages []pageRequest
+	for _, r := range rec.all() {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		pages = append(pages, pageRequest{offset, limit})
+	}
+	return pages
+}
+
+func TestPaginateAll(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{}, pagingHandler(250))
+
+	var hashes []string
+	for tx, err := range client.TokenTxsV3All(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, tx.TxHash)
+	}
+	if len(hashes) != 250 || hashes[0] != "tx-0" || hashes[249] != "tx-249" {
+		t.Fatalf("Expected 250 transactions in order, got %d", len(hashes))
+	}
+	expected := []pageRequest{{0, 100}, {100, 100}, {200, 100}}
+	if fmt.Sprint(requestedPages(rec)) != fmt.Sprint(expected) {
+		t.Fatalf("Expected pages %v, got %v", expected, requestedPages(rec))
+	}
+}
+
+func TestPaginateBreak(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{}, pagingHandler(1000))
+
+	seen := 0
+	for _, err := range client.TokenTxsV3All(context.Background(), testTokenSOL, &TokenTxsV3Options{Limit: 50}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seen++; seen == 60 {
+			break
+		}
+	}
+	if len(requestedPages(rec)) != 2 {
+		t.Fatalf("Expected fetching to stop at the break, got pages %v", requestedPages(rec))
+	}
+}
+
+func TestPaginateWindow(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{}, pagingHandler(20000))
+
+	count := 0
+	for _, err := range client.TokenTxsV3All(context.Background(), testTokenSOL, &TokenTxsV3Options{Offset: 9850}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	expected := []pageRequest{{9850, 100}, {9950, 50}}
+	if count != 150 || fmt.Sprint(requestedPages(rec)) != fmt.Sprint(expected) {
+		t.Fatalf("Expected to stop at offset + limit = %d, got %d items from pages %v", MaxPaginationWindow, count, requestedPages(rec))
+	}
+}
+
+func TestPaginateEndpointWindow(t *testing.T) {
+	serve := pagingHandler(1000)
+	client, rec := newRecordingClient(t, HTTPClientConfig{
+		PaginationWindows: map[string]int64{EndpointDefiV3TokenHolder: 250},
+	}, func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if offset+limit > 250 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"success":false,"message":"offset + limit must not exceed 250"}`))
+			return
+		}
+		serve(w, r)
+	})
+
+	count := 0
+	for _, err := range client.TokenHoldersAll(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		count++
+	}
+	expected := []pageRequest{{0, 100}, {100, 100}, {200, 50}}
+	if count != 250 || fmt.Sprint(requestedPages(rec)) != fmt.Sprint(expected) {
+		t.Fatalf("Expected to stop at the endpoint window, got %d items from pages %v", count, requestedPages(rec))
+	}
+	if window := client.paginationWindow(EndpointDefiV3TokenTxs); window != MaxPaginationWindow {
+		t.Errorf("Expected MaxPaginationWindow for other endpoints, got %d", window)
+	}
+}
+
+func TestPaginateSkipped(t *testing.T) {
+	client, rec := newRecordingClient(t, skippingConfig(t, EndpointDefiV3TokenTxs, 1), pagingHandler(250))
+
+	count := 0
+	var errs []error
+	for _, err := range client.TokenTxsV3All(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	if count != 100 || len(errs) != 1 || !errors.Is(errs[0], ErrRateLimitExceeded) {
+		t.Fatalf("Expected 100 transactions then ErrRateLimitExceeded, got %d and %v", count, errs)
+	}
+	if rec.count() != 1 {
+		t.Fatalf("Expected the skipped page not to be requested, got pages %v", requestedPages(rec))
+	}
+}
+
+func TestPaginateSlice(t *testing.T) {
+	client, rec := newRecordingClient(t, HTTPClientConfig{}, pagingHandler(150))
+
+	count := 0
+	for holder, err := range client.TokenHoldersAll(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if holder.Owner != fmt.Sprintf("owner-%d", count) {
+			t.Fatalf("Unexpected holder %d: %+v", count, holder)
+		}
+		count++
+	}
+	// Without a next-page flag, a short page is the last one
+	if count != 150 || len(requestedPages(rec)) != 2 {
+		t.Fatalf("Expected 150 holders from 2 pages, got %d from %v", count, requestedPages(rec))
+	}
+}
+
+func TestPaginateError(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"success":false,"message":"offset too large"}`))
+	}, nil)
+
+	var errs []error
+	for _, err := range client.TokenTxsV3All(context.Background(), testTokenSOL, nil) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrBadRequest) {
+		t.Fatalf("Expected a single ErrBadRequest, got %v", errs)
+	}
+}
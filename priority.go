@@ -0,0 +1,181 @@
+package birdeye
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Request priorities for rate limited calls.
+//
+// Blocked acquisitions (RateLimitBlock) wait in a queue ordered by priority.
+// To keep low priority work from starving, waiting ages a request: every
+// aging period (DefaultPriorityAging, see RateLimiter.SetPriorityAging) of
+// waiting counts as one priority level. A low priority
+// request that has waited twice the aging period is therefore served before
+// a high priority request that just arrived.
+
+// ============================================================================
+// Priority
+// ============================================================================
+
+// Priority is the priority of a request waiting for a rate limit token.
+type Priority int
+
+const (
+	PriorityLow    Priority = -1
+	PriorityNormal Priority = 0
+	PriorityHigh   Priority = 1
+)
+
+// DefaultPriorityAging is the waiting time that raises a request by one priority level.
+const DefaultPriorityAging = time.Second
+
+type priorityKey struct{}
+
+// WithPriority returns a context carrying the priority used when the request waits for a rate limit token.
+//
+// Example:
+//
+//	// Latency-critical price check
+//	price, err := client.GetTokenPrice(birdeye.WithPriority(ctx, birdeye.PriorityHigh), address, nil)
+//
+//	// Bulk backfill
+//	txs, err := client.GetTokenTxsV3(birdeye.WithPriority(ctx, birdeye.PriorityLow), address, opts)
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority carried by the context, PriorityNormal if none.
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// ============================================================================
+// Wait Queue
+// ============================================================================
+
+// waiter is a blocked acquisition.
+type waiter struct {
+	seq     uint64
+	virtual time.Time     // Arrival time shifted by priority, the queue order
+	wake    chan struct{} // Signalled when the waiter becomes head of the queue
+}
+
+// waitQueue orders blocked acquisitions of a limiter.
+//
+// Waiters are sorted by virtual arrival time, i.e. arrival time minus
+// priority * aging. Since the shift is fixed when a waiter enters the queue,
+// the order never changes while waiting and equal priorities stay FIFO.
+// It is protected by the mutex of the limiter that owns it.
+type waitQueue struct {
+	clock   Clock // Clock of the limiter that owns the queue
+	aging   time.Duration
+	seq     uint64
+	waiters []*waiter
+}
+
+// setAging changes the waiting time that raises a request by one priority level.
+func (q *waitQueue) setAging(aging time.Duration) {
+	q.aging = aging
+}
+
+// push adds a waiter to the queue.
+func (q *waitQueue) push(priority Priority) *waiter {
+	aging := q.aging
+	if aging <= 0 {
+		aging = DefaultPriorityAging
+	}
+
+	q.seq++
+	w := &waiter{
+		seq:     q.seq,
+		virtual: q.clock.Now().Add(-time.Duration(priority) * aging),
+		wake:    make(chan struct{}, 1),
+	}
+	i, _ := slices.BinarySearchFunc(q.waiters, w, func(a, b *waiter) int {
+		if c := a.virtual.Compare(b.virtual); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.seq, b.seq)
+	})
+	q.waiters = slices.Insert(q.waiters, i, w)
+	return w
+}
+
+// remove removes a waiter and wakes the new head of the queue.
+func (q *waitQueue) remove(w *waiter) {
+	if i := slices.Index(q.waiters, w); i >= 0 {
+		q.waiters = slices.Delete(q.waiters, i, i+1)
+	}
+	if len(q.waiters) > 0 {
+		select {
+		case q.waiters[0].wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// empty reports whether no acquisition is waiting.
+func (q *waitQueue) empty() bool {
+	return len(q.waiters) == 0
+}
+
+// wait queues an acquisition until it is head of the queue and reserve succeeds,
+// then sleeps until the reservation is ready.
+//
+// mu must be held by the caller and is released while sleeping. reserve makes a
+// reservation and returns the delay until it may proceed and the function
+// undoing it, called with mu held if the wait is cancelled. If it cannot
+// reserve yet it returns false and the delay before trying again.
+func (q *waitQueue) wait(ctx context.Context, mu sync.Locker, reserve func() (time.Duration, func(), bool)) (bool, error) {
+	w := q.push(PriorityFromContext(ctx))
+
+	for {
+		// Only the head of the queue waits for tokens, the others wait for their turn
+		var timer Timer
+		var timerC <-chan time.Time
+		if q.waiters[0] == w {
+			delay, undo, ok := reserve()
+			if ok {
+				q.remove(w)
+				if delay <= 0 {
+					return true, nil
+				}
+				mu.Unlock()
+				err := sleepContext(ctx, q.clock, delay)
+				mu.Lock()
+				if err != nil {
+					undo()
+					return false, err
+				}
+				return true, nil
+			}
+			timer = q.clock.NewTimer(delay)
+			timerC = timer.C()
+		}
+
+		mu.Unlock()
+		var err error
+		select {
+		case <-timerC:
+		case <-w.wake:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		mu.Lock()
+
+		if err != nil {
+			q.remove(w)
+			return false, err
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package birdeye
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// acquireOrder starts one blocked acquisition per priority, in the given
+// order, against an exhausted limiter and returns the order they completed in.
+func acquireOrder(t *testing.T, limiter Limiter, priorities []Priority) []Priority {
+	t.Helper()
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+
+	for _, priority := range priorities {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := WithPriority(context.Background(), priority)
+			if _, err := limiter.Acquire(ctx, 1, nil); err != nil {
+				t.Error(err)
+				return
+			}
+			mu.Lock()
+			order = append(order, priority)
+			mu.Unlock()
+		}()
+		// Let the goroutine enter the queue before the next one
+		time.Sleep(5 * time.Millisecond)
+	}
+	wg.Wait()
+	return order
+}
+
+func TestPriorityOrder(t *testing.T) {
+	rl, _ := NewRateLimiter(10, time.Second, RateLimitBlock)
+	rl.SetPriorityAging(time.Minute)
+	mrl, _ := NewMultiRateLimiter([]RateLimit{{Limit: 10, Period: time.Second}}, RateLimitBlock)
+	mrl.SetPriorityAging(time.Minute)
+
+	for name, limiter := range map[string]interface {
+		Limiter
+		TryAcquire(int) bool
+	}{"RateLimiter": rl, "MultiRateLimiter": mrl} {
+		t.Run(name, func(t *testing.T) {
+			for limiter.TryAcquire(1) {
+			}
+			// The first request reserves the next token as soon as it arrives,
+			// the others are queued behind it in priority order
+			order := acquireOrder(t, limiter, []Priority{PriorityLow, PriorityLow, PriorityNormal, PriorityHigh})
+			expected := []Priority{PriorityLow, PriorityHigh, PriorityNormal, PriorityLow}
+			for i := range expected {
+				if order[i] != expected[i] {
+					t.Fatalf("Expected order %v, got %v", expected, order)
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityAging(t *testing.T) {
+	limiter, _ := NewRateLimiter(20, time.Second, RateLimitBlock)
+	limiter.SetPriorityAging(time.Millisecond)
+	for limiter.TryAcquire(1) {
+	}
+
+	// The second low priority request has waited longer than two aging
+	// periods when the high priority request arrives
+	order := acquireOrder(t, limiter, []Priority{PriorityNormal, PriorityLow, PriorityHigh})
+	if order[1] != PriorityLow {
+		t.Fatalf("Expected aged low priority request first, got %v", order)
+	}
+}
+
+func TestPriorityCancelledWaiter(t *testing.T) {
+	limiter, _ := NewRateLimiter(10, time.Second, RateLimitBlock)
+	for limiter.TryAcquire(1) {
+	}
+
+	ctx, cancel := context.WithTimeout(WithPriority(context.Background(), PriorityHigh), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Acquire(ctx, 1, nil); err == nil {
+		t.Fatal("Expected context error")
+	}
+
+	// The cancelled waiter left the queue
+	acquired, err := limiter.Acquire(context.Background(), 1, nil)
+	if err != nil || !acquired {
+		t.Fatalf("Expected acquisition after cancelled waiter, got %v %v", acquired, err)
+	}
+	if !limiter.queue.empty() {
+		t.Error("Expected empty queue")
+	}
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	if p := PriorityFromContext(context.Background()); p != PriorityNormal {
+		t.Errorf("Expected PriorityNormal, got %v", p)
+	}
+	if p := PriorityFromContext(WithPriority(context.Background(), PriorityLow)); p != PriorityLow {
+		t.Errorf("Expected PriorityLow, got %v", p)
+	}
+}
@@ -3,6 +3,8 @@ package birdeye
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"time"
 )
@@ -31,6 +33,78 @@ const (
 // ErrRateLimitExceeded is returned when rate limit is exceeded and behavior is RateLimitRaise
 var ErrRateLimitExceeded = errors.New("rate limit exceeded")
 
+// ============================================================================
+// Limiter Interface
+// ============================================================================
+
+// Limiter is the interface HTTPClient uses to rate limit requests.
+//
+// RateLimiter, SharedRateLimiter, MultiRateLimiter and SlidingWindowLimiter
+// implement it, as does BackendLimiter, which shares its limits across
+// processes through a SharedLimiterBackend. Custom implementations (e.g. a
+// limiter shared across services) can be plugged in through
+// HTTPClientConfig.CategoryLimiters and HTTPClientConfig.EndpointLimiters.
+type Limiter interface {
+	// Acquire attempts to acquire tokens. onLimitExceeded overrides the
+	// limiter's default behavior when not nil.
+	Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error)
+
+	// Wait blocks until a token is available or the context is cancelled.
+	Wait(ctx context.Context) error
+
+	// GetStatus returns the status of each rate limit enforced by the limiter.
+	GetStatus() []LimiterStatus
+}
+
+// LimiterStatus describes the state of a single rate limit.
+type LimiterStatus struct {
+	Limit           int
+	Period          time.Duration
+	AvailableTokens float64
+}
+
+var (
+	_ Limiter = (*RateLimiter)(nil)
+	_ Limiter = (*SharedRateLimiter)(nil)
+	_ Limiter = (*MultiRateLimiter)(nil)
+	_ Limiter = (*SlidingWindowLimiter)(nil)
+)
+
+// ============================================================================
+// Limiter Options
+// ============================================================================
+
+// LimiterOption configures a limiter created by NewRateLimiter,
+// NewSharedRateLimiter, NewSlidingWindowLimiter or NewMultiRateLimiter.
+type LimiterOption func(*limiterOptions)
+
+// limiterOptions holds the settings applied by LimiterOption.
+type limiterOptions struct {
+	clock Clock
+}
+
+// newLimiterOptions applies the options over the defaults.
+func newLimiterOptions(opts []LimiterOption) limiterOptions {
+	o := limiterOptions{clock: RealClock{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithClock sets the clock a limiter reads the time from and waits on.
+// A nil clock selects the system clock.
+//
+// Example:
+//
+//	clock := birdeye.NewManualClock(time.Now())
+//	limiter, _ := birdeye.NewRateLimiter(10, time.Second, birdeye.RateLimitBlock, birdeye.WithClock(clock))
+func WithClock(clock Clock) LimiterOption {
+	return func(o *limiterOptions) {
+		o.clock = clockOrReal(clock)
+	}
+}
+
 // ============================================================================
 // RateLimiter - Token Bucket Implementation
 // ============================================================================
@@ -44,6 +118,8 @@ type RateLimiter struct {
 	onLimitExceeded RateLimitBehavior // Behavior when rate limit is exceeded
 	tokens          float64           // Current number of available tokens
 	lastUpdate      time.Time         // Last time tokens were refilled
+	clock           Clock             // Time source for refills and waits
+	queue           waitQueue         // Blocked acquisitions in priority order
 	mu              sync.RWMutex      // Mutex for thread-safe operations
 }
 
@@ -53,11 +129,12 @@ type RateLimiter struct {
 //   - limit: Maximum number of calls allowed in the given period
 //   - period: Time period for the rate limit
 //   - onLimitExceeded: Behavior when rate limit is exceeded (default: RateLimitBlock)
+//   - opts: Optional settings such as WithClock
 //
 // Returns:
 //   - *RateLimiter: A new rate limiter instance
 //   - error: Error if parameters are invalid
-func NewRateLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBehavior) (*RateLimiter, error) {
+func NewRateLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (*RateLimiter, error) {
 	if limit <= 0 {
 		return nil, errors.New("limit must be positive")
 	}
@@ -65,18 +142,21 @@ func NewRateLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBe
 		return nil, errors.New("period must be positive")
 	}
 
+	o := newLimiterOptions(opts)
 	return &RateLimiter{
 		limit:           limit,
 		period:          period,
 		onLimitExceeded: onLimitExceeded,
 		tokens:          float64(limit),
-		lastUpdate:      time.Now(),
+		lastUpdate:      o.clock.Now(),
+		clock:           o.clock,
+		queue:           waitQueue{clock: o.clock},
 	}, nil
 }
 
 // refillTokens refills tokens based on elapsed time since last refill.
 func (rl *RateLimiter) refillTokens() {
-	now := time.Now()
+	now := rl.clock.Now()
 	elapsed := now.Sub(rl.lastUpdate)
 
 	// Calculate tokens to add based on elapsed time
@@ -87,8 +167,15 @@ func (rl *RateLimiter) refillTokens() {
 
 // Acquire attempts to acquire tokens from the bucket.
 //
+// Blocked acquisitions wait in a queue served in priority order (FIFO within a
+// priority, see WithPriority). When its turn comes, a waiter reserves its tokens
+// and sleeps until they are refilled, so no other caller can take them in the
+// meantime. In RateLimitBlock mode Acquire therefore either acquires the tokens
+// or returns the context error. Tokens are not handed out while acquisitions
+// are waiting.
+//
 // Args:
-//   - ctx: Context for cancellation
+//   - ctx: Context for cancellation, optionally carrying a Priority
 //   - tokens: Number of tokens to acquire (default: 1)
 //   - onLimitExceeded: Optional override for the default behavior
 //
@@ -104,43 +191,18 @@ func (rl *RateLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
-	rl.refillTokens()
-
-	if rl.tokens >= float64(tokens) {
-		rl.tokens -= float64(tokens)
+	if rl.queue.empty() && rl.take(tokens) {
 		return true, nil
 	}
 
 	switch behavior {
 	case RateLimitBlock:
-		// Calculate wait time for next token
-		tokensNeeded := float64(tokens) - rl.tokens
-		waitTime := time.Duration(tokensNeeded * rl.period.Seconds() / float64(rl.limit) * float64(time.Second))
-
-		// Release lock while waiting
-		rl.mu.Unlock()
-
-		// Wait with context support
-		timer := time.NewTimer(waitTime)
-		select {
-		case <-timer.C:
-			// Continue after wait
-		case <-ctx.Done():
-			timer.Stop()
-			rl.mu.Lock()
-			return false, ctx.Err()
-		}
-
-		// Re-acquire lock
-		rl.mu.Lock()
-
-		// Refill and try again
-		rl.refillTokens()
-		if rl.tokens >= float64(tokens) {
-			rl.tokens -= float64(tokens)
-			return true, nil
+		if tokens > rl.limit {
+			return false, fmt.Errorf("cannot acquire %d tokens from a limit of %d", tokens, rl.limit)
 		}
-		return false, nil
+		return rl.queue.wait(ctx, &rl.mu, func() (time.Duration, func(), bool) {
+			return reserveWhenDue([]rateTier{rl}, tokens)
+		})
 
 	case RateLimitRaise:
 		return false, ErrRateLimitExceeded
@@ -150,6 +212,71 @@ func (rl *RateLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded
 	}
 }
 
+// take refills the bucket and takes tokens if available.
+// The caller must hold the lock.
+func (rl *RateLimiter) take(tokens int) bool {
+	rl.refillTokens()
+	if rl.tokens >= float64(tokens) {
+		rl.tokens -= float64(tokens)
+		return true
+	}
+	return false
+}
+
+// pending returns the delay until outstanding reservations are paid back,
+// false if there are none. The caller must hold the lock.
+func (rl *RateLimiter) pending() (time.Duration, bool) {
+	rl.refillTokens()
+	if rl.tokens < 0 {
+		return rl.durationFor(-rl.tokens), true
+	}
+	return 0, false
+}
+
+// reserveNow takes tokens, letting the bucket go into debt, and returns the
+// delay until they are refilled and the function undoing the reservation.
+// The caller must hold the lock, also when undoing.
+func (rl *RateLimiter) reserveNow(tokens int) (time.Duration, func()) {
+	rl.refillTokens()
+	rl.tokens -= float64(tokens)
+	return rl.durationFor(max(0, -rl.tokens)), func() { rl.unreserve(tokens) }
+}
+
+// timeUntil returns the time until tokens can be taken.
+// The caller must hold the lock.
+func (rl *RateLimiter) timeUntil(tokens int) time.Duration {
+	return rl.durationFor(max(0, float64(tokens)-rl.peekTokens()))
+}
+
+// available returns the current number of available tokens.
+// The caller must hold the lock.
+func (rl *RateLimiter) available() float64 {
+	return max(0, rl.peekTokens())
+}
+
+func (rl *RateLimiter) lock()   { rl.mu.Lock() }
+func (rl *RateLimiter) unlock() { rl.mu.Unlock() }
+
+// unreserve returns the tokens of a cancelled reservation.
+// The caller must hold the lock.
+func (rl *RateLimiter) unreserve(tokens int) {
+	rl.refillTokens()
+	rl.tokens = min(float64(rl.limit), rl.tokens+float64(tokens))
+}
+
+// durationFor returns the time needed to refill the given number of tokens.
+func (rl *RateLimiter) durationFor(tokens float64) time.Duration {
+	return time.Duration(tokens * rl.period.Seconds() / float64(rl.limit) * float64(time.Second))
+}
+
+// SetPriorityAging sets the waiting time that raises a blocked acquisition by
+// one priority level, see WithPriority. Default: DefaultPriorityAging.
+func (rl *RateLimiter) SetPriorityAging(aging time.Duration) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.queue.setAging(aging)
+}
+
 // AcquireWithTimeout attempts to acquire tokens with a timeout.
 //
 // This is a convenience method that creates a context with timeout.
@@ -174,20 +301,50 @@ func (rl *RateLimiter) Reset() {
 	defer rl.mu.Unlock()
 
 	rl.tokens = float64(rl.limit)
-	rl.lastUpdate = time.Now()
+	rl.lastUpdate = rl.clock.Now()
+}
+
+// SetLimit changes the number of calls allowed per period at runtime.
+//
+// Tokens accumulated under the previous limit are kept, capped at the new limit.
+func (rl *RateLimiter) SetLimit(limit int) error {
+	if limit <= 0 {
+		return errors.New("limit must be positive")
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	rl.refillTokens()
+	rl.limit = limit
+	rl.tokens = min(float64(limit), rl.tokens)
+	return nil
+}
+
+// Limit returns the number of calls allowed per period.
+func (rl *RateLimiter) Limit() int {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+	return rl.limit
 }
 
 // GetAvailableTokens returns the current number of available tokens.
 func (rl *RateLimiter) GetAvailableTokens() float64 {
 	rl.mu.RLock()
 	defer rl.mu.RUnlock()
+	return rl.available()
+}
 
+// peekTokens returns the available tokens without refilling the bucket.
+// The caller must hold the lock.
+func (rl *RateLimiter) peekTokens() float64 {
 	// Create a temporary copy to refill
 	tmpRL := &RateLimiter{
 		limit:      rl.limit,
 		period:     rl.period,
 		tokens:     rl.tokens,
 		lastUpdate: rl.lastUpdate,
+		clock:      rl.clock,
 	}
 	tmpRL.refillTokens()
 	return tmpRL.tokens
@@ -198,22 +355,36 @@ func (rl *RateLimiter) TimeUntilNextToken() time.Duration {
 	rl.mu.RLock()
 	defer rl.mu.RUnlock()
 
-	// Create a temporary copy to refill
-	tmpRL := &RateLimiter{
-		limit:      rl.limit,
-		period:     rl.period,
-		tokens:     rl.tokens,
-		lastUpdate: rl.lastUpdate,
-	}
-	tmpRL.refillTokens()
+	return rl.timeUntil(1)
+}
 
-	if tmpRL.tokens >= 1 {
-		return 0
-	}
+// Reserve reserves tokens without blocking and returns when they may be used.
+//
+// The tokens are taken immediately, letting the bucket go into debt, so later
+// callers wait behind the reservation. Reservations are not queued: they are
+// placed behind outstanding reservations but ahead of waiting Acquire calls.
+// The reservation is not OK if tokens exceeds the limit.
+//
+// Example:
+//
+//	r := limiter.Reserve(1)
+//	if !r.OK() {
+//	    return errors.New("request exceeds the limit")
+//	}
+//	schedule(r.ReadyAt(), job) // or r.Cancel() if the job is dropped
+func (rl *RateLimiter) Reserve(tokens int) *Reservation {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
 
-	tokensNeeded := 1 - tmpRL.tokens
-	waitSeconds := tokensNeeded * tmpRL.period.Seconds() / float64(tmpRL.limit)
-	return time.Duration(waitSeconds * float64(time.Second))
+	if tokens > rl.limit {
+		return &Reservation{}
+	}
+	delay, undo := rl.reserveNow(tokens)
+	return newReservation(rl.clock, delay, func() {
+		rl.mu.Lock()
+		defer rl.mu.Unlock()
+		undo()
+	})
 }
 
 // Wait blocks until a token is available or context is cancelled.
@@ -222,6 +393,18 @@ func (rl *RateLimiter) Wait(ctx context.Context) error {
 	return err
 }
 
+// GetStatus returns the status of the rate limiter.
+func (rl *RateLimiter) GetStatus() []LimiterStatus {
+	rl.mu.RLock()
+	defer rl.mu.RUnlock()
+
+	return []LimiterStatus{{
+		Limit:           rl.limit,
+		Period:          rl.period,
+		AvailableTokens: rl.available(),
+	}}
+}
+
 // ============================================================================
 // SharedRateLimiter - Shared Rate Limiter Across Multiple Operations
 // ============================================================================
@@ -235,8 +418,8 @@ type SharedRateLimiter struct {
 }
 
 // NewSharedRateLimiter creates a new shared rate limiter.
-func NewSharedRateLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBehavior) (*SharedRateLimiter, error) {
-	limiter, err := NewRateLimiter(limit, period, onLimitExceeded)
+func NewSharedRateLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (*SharedRateLimiter, error) {
+	limiter, err := NewRateLimiter(limit, period, onLimitExceeded, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -271,6 +454,21 @@ func (srl *SharedRateLimiter) Wait(ctx context.Context) error {
 	return srl.limiter.Wait(ctx)
 }
 
+// SetPriorityAging sets the waiting time that raises a blocked acquisition by one priority level.
+func (srl *SharedRateLimiter) SetPriorityAging(aging time.Duration) {
+	srl.limiter.SetPriorityAging(aging)
+}
+
+// Reserve reserves tokens without blocking, see RateLimiter.Reserve.
+func (srl *SharedRateLimiter) Reserve(tokens int) *Reservation {
+	return srl.limiter.Reserve(tokens)
+}
+
+// GetStatus returns the status of the shared limiter.
+func (srl *SharedRateLimiter) GetStatus() []LimiterStatus {
+	return srl.limiter.GetStatus()
+}
+
 // ============================================================================
 // MultiRateLimiter - Multi-Tiered Rate Limiter
 // ============================================================================
@@ -279,6 +477,92 @@ func (srl *SharedRateLimiter) Wait(ctx context.Context) error {
 type RateLimit struct {
 	Limit  int
 	Period time.Duration
+
+	// Algorithm selects how the limit is enforced inside a MultiRateLimiter.
+	// Optional, default: RateLimitTokenBucket
+	Algorithm RateLimitAlgorithm
+}
+
+// RateLimitAlgorithm selects how a RateLimit is enforced.
+type RateLimitAlgorithm string
+
+const (
+	// RateLimitTokenBucket allows bursts up to the limit and refills continuously (RateLimiter)
+	RateLimitTokenBucket RateLimitAlgorithm = "token_bucket"
+	// RateLimitSlidingWindow never allows more than the limit within any period (SlidingWindowLimiter)
+	RateLimitSlidingWindow RateLimitAlgorithm = "sliding_window"
+)
+
+// rateTier is a single rate limit enforced by a MultiRateLimiter.
+//
+// Except for the Limiter methods, SetLimit, Reset, lock and unlock, the methods
+// must be called with the tier locked.
+type rateTier interface {
+	Limiter
+	SetLimit(limit int) error
+	Reset()
+
+	lock()
+	unlock()
+	take(tokens int) bool
+	pending() (time.Duration, bool)
+	reserveNow(tokens int) (time.Duration, func())
+	timeUntil(tokens int) time.Duration
+}
+
+var (
+	_ rateTier = (*RateLimiter)(nil)
+	_ rateTier = (*SlidingWindowLimiter)(nil)
+)
+
+// newTier creates the limiter enforcing a rate limit with its algorithm.
+func newTier(limit RateLimit, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (rateTier, error) {
+	switch limit.Algorithm {
+	case "", RateLimitTokenBucket:
+		return NewRateLimiter(limit.Limit, limit.Period, onLimitExceeded, opts...)
+	case RateLimitSlidingWindow:
+		return NewSlidingWindowLimiter(limit.Limit, limit.Period, onLimitExceeded, opts...)
+	default:
+		return nil, fmt.Errorf("unknown rate limit algorithm %q", limit.Algorithm)
+	}
+}
+
+// reserveWhenDue reserves tokens on all tiers for the head of a wait queue.
+// If a tier still has an outstanding reservation it reserves nothing and
+// returns the delay until all of them are due. The tiers must be locked.
+func reserveWhenDue(tiers []rateTier, tokens int) (time.Duration, func(), bool) {
+	pending := false
+	var wait time.Duration
+	for _, tier := range tiers {
+		if d, ok := tier.pending(); ok {
+			pending = true
+			wait = max(wait, d)
+		}
+	}
+	if pending {
+		return wait, nil, false
+	}
+
+	wait, undo := reserveAll(tiers, tokens)
+	return wait, undo, true
+}
+
+// reserveAll reserves tokens on all tiers and returns the delay until every
+// tier allows them, and the function undoing the reservation. The tiers must
+// be locked, also when undoing.
+func reserveAll(tiers []rateTier, tokens int) (time.Duration, func()) {
+	var wait time.Duration
+	undos := make([]func(), len(tiers))
+	for i, tier := range tiers {
+		var d time.Duration
+		d, undos[i] = tier.reserveNow(tokens)
+		wait = max(wait, d)
+	}
+	return wait, func() {
+		for _, undo := range undos {
+			undo()
+		}
+	}
 }
 
 // MultiRateLimiter enforces multiple rate limits simultaneously.
@@ -288,16 +572,22 @@ type RateLimit struct {
 // All rate limits must be satisfied for a request to proceed.
 type MultiRateLimiter struct {
 	limits          []RateLimit
-	limiters        []*RateLimiter
+	tiers           []rateTier
 	onLimitExceeded RateLimitBehavior
+	clock           Clock
+	queue           waitQueue
 	mu              sync.RWMutex
 }
 
 // NewMultiRateLimiter creates a new multi-tiered rate limiter.
 //
+// Each limit is enforced by a token bucket unless its Algorithm selects a
+// sliding window.
+//
 // Args:
 //   - limits: List of rate limit configurations
 //   - onLimitExceeded: Behavior when any rate limit is exceeded
+//   - opts: Optional settings such as WithClock, applied to every tier
 //
 // Example:
 //
@@ -305,36 +595,43 @@ type MultiRateLimiter struct {
 //	limiter, _ := NewMultiRateLimiter(
 //	    []RateLimit{
 //	        {Limit: 10, Period: time.Second},
-//	        {Limit: 100, Period: time.Minute},
+//	        {Limit: 100, Period: time.Minute, Algorithm: RateLimitSlidingWindow},
 //	        {Limit: 1000, Period: time.Hour},
 //	    },
 //	    RateLimitBlock,
 //	)
-func NewMultiRateLimiter(limits []RateLimit, onLimitExceeded RateLimitBehavior) (*MultiRateLimiter, error) {
+func NewMultiRateLimiter(limits []RateLimit, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (*MultiRateLimiter, error) {
 	if len(limits) == 0 {
 		return nil, errors.New("at least one rate limit must be specified")
 	}
 
+	o := newLimiterOptions(opts)
 	mrl := &MultiRateLimiter{
-		limits:          limits,
-		limiters:        make([]*RateLimiter, 0, len(limits)),
+		limits:          slices.Clone(limits),
+		tiers:           make([]rateTier, 0, len(limits)),
 		onLimitExceeded: onLimitExceeded,
+		clock:           o.clock,
+		queue:           waitQueue{clock: o.clock},
 	}
 
-	// Create a RateLimiter for each limit
+	// Create a limiter for each limit
 	// Use 'skip' mode for individual limiters since we'll handle blocking here
 	for _, limit := range limits {
-		limiter, err := NewRateLimiter(limit.Limit, limit.Period, RateLimitSkip)
+		tier, err := newTier(limit, RateLimitSkip, WithClock(o.clock))
 		if err != nil {
 			return nil, err
 		}
-		mrl.limiters = append(mrl.limiters, limiter)
+		mrl.tiers = append(mrl.tiers, tier)
 	}
 
 	return mrl, nil
 }
 
 // Acquire attempts to acquire tokens from all rate limiters.
+//
+// Tokens are taken from all tiers atomically: either every tier is charged or
+// none is. Blocked acquisitions are queued and reserved like in
+// RateLimiter.Acquire, see WithPriority.
 func (mrl *MultiRateLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error) {
 	behavior := mrl.onLimitExceeded
 	if onLimitExceeded != nil {
@@ -344,77 +641,78 @@ func (mrl *MultiRateLimiter) Acquire(ctx context.Context, tokens int, onLimitExc
 	mrl.mu.Lock()
 	defer mrl.mu.Unlock()
 
-	// Check if all limiters can satisfy the request
-	canProceed := true
-	for _, limiter := range mrl.limiters {
-		if limiter.GetAvailableTokens() < float64(tokens) {
-			canProceed = false
-			break
-		}
-	}
-
-	if canProceed {
-		// Acquire from all limiters
-		for _, limiter := range mrl.limiters {
-			limiter.TryAcquire(tokens)
-		}
+	if mrl.queue.empty() && mrl.take(tokens) {
 		return true, nil
 	}
 
 	switch behavior {
 	case RateLimitBlock:
-		// Calculate maximum wait time needed across all limiters
-		var maxWaitTime time.Duration
-		for _, limiter := range mrl.limiters {
-			if limiter.GetAvailableTokens() < float64(tokens) {
-				tokensNeeded := float64(tokens) - limiter.GetAvailableTokens()
-				waitTime := time.Duration(tokensNeeded * limiter.period.Seconds() / float64(limiter.limit) * float64(time.Second))
-				if waitTime > maxWaitTime {
-					maxWaitTime = waitTime
-				}
+		for _, limit := range mrl.limits {
+			if tokens > limit.Limit {
+				return false, fmt.Errorf("cannot acquire %d tokens from a limit of %d", tokens, limit.Limit)
 			}
 		}
+		return mrl.queue.wait(ctx, &mrl.mu, func() (time.Duration, func(), bool) {
+			defer mrl.lockAll()()
+			wait, undo, ok := reserveWhenDue(mrl.tiers, tokens)
+			return wait, mrl.lockedUndo(undo), ok
+		})
 
-		// Release lock while waiting
-		mrl.mu.Unlock()
-
-		// Wait with context support
-		timer := time.NewTimer(maxWaitTime)
-		select {
-		case <-timer.C:
-			// Continue after wait
-		case <-ctx.Done():
-			timer.Stop()
-			mrl.mu.Lock()
-			return false, ctx.Err()
-		}
+	case RateLimitRaise:
+		return false, ErrRateLimitExceeded
 
-		// Re-acquire lock
-		mrl.mu.Lock()
+	default: // RateLimitSkip
+		return false, nil
+	}
+}
 
-		// Try again after waiting
-		allReady := true
-		for _, limiter := range mrl.limiters {
-			if limiter.GetAvailableTokens() < float64(tokens) {
-				allReady = false
-				break
-			}
+// lockAll locks every tier and returns the function unlocking them.
+// The caller must hold the lock of the MultiRateLimiter.
+func (mrl *MultiRateLimiter) lockAll() func() {
+	for _, tier := range mrl.tiers {
+		tier.lock()
+	}
+	return func() {
+		for _, tier := range mrl.tiers {
+			tier.unlock()
 		}
+	}
+}
 
-		if allReady {
-			for _, limiter := range mrl.limiters {
-				limiter.TryAcquire(tokens)
-			}
-			return true, nil
-		}
-		return false, nil
+// lockedUndo wraps the undo function of a reservation to lock the tiers.
+// The caller must hold the lock of the MultiRateLimiter when undoing.
+func (mrl *MultiRateLimiter) lockedUndo(undo func()) func() {
+	if undo == nil {
+		return nil
+	}
+	return func() {
+		defer mrl.lockAll()()
+		undo()
+	}
+}
 
-	case RateLimitRaise:
-		return false, ErrRateLimitExceeded
+// take takes tokens from all tiers if every one of them can satisfy the request.
+// The caller must hold the lock.
+func (mrl *MultiRateLimiter) take(tokens int) bool {
+	defer mrl.lockAll()()
 
-	default: // RateLimitSkip
-		return false, nil
+	for _, tier := range mrl.tiers {
+		if tier.timeUntil(tokens) > 0 {
+			return false
+		}
+	}
+	for _, tier := range mrl.tiers {
+		tier.take(tokens)
 	}
+	return true
+}
+
+// SetPriorityAging sets the waiting time that raises a blocked acquisition by
+// one priority level, see WithPriority. Default: DefaultPriorityAging.
+func (mrl *MultiRateLimiter) SetPriorityAging(aging time.Duration) {
+	mrl.mu.Lock()
+	defer mrl.mu.Unlock()
+	mrl.queue.setAging(aging)
 }
 
 // TryAcquire attempts to acquire tokens without blocking.
@@ -429,31 +727,40 @@ func (mrl *MultiRateLimiter) Reset() {
 	mrl.mu.Lock()
 	defer mrl.mu.Unlock()
 
-	for _, limiter := range mrl.limiters {
-		limiter.Reset()
+	for _, tier := range mrl.tiers {
+		tier.Reset()
 	}
 }
 
-// GetStatus returns the status of all rate limiters.
+// SetLimit changes the limit of one tier at runtime.
 //
-// Returns a slice of (limit, period, availableTokens) for each limiter.
-type LimiterStatus struct {
-	Limit           int
-	Period          time.Duration
-	AvailableTokens float64
+// Args:
+//   - index: Position of the tier in the limits passed to NewMultiRateLimiter
+//   - limit: New number of calls allowed per period of that tier
+func (mrl *MultiRateLimiter) SetLimit(index, limit int) error {
+	mrl.mu.Lock()
+	defer mrl.mu.Unlock()
+
+	if index < 0 || index >= len(mrl.tiers) {
+		return fmt.Errorf("rate limit index %d out of range", index)
+	}
+	if err := mrl.tiers[index].SetLimit(limit); err != nil {
+		return err
+	}
+	mrl.limits[index].Limit = limit
+	return nil
 }
 
+// GetStatus returns the status of all rate limiters.
+//
+// Returns a slice of (limit, period, availableTokens) for each limiter.
 func (mrl *MultiRateLimiter) GetStatus() []LimiterStatus {
 	mrl.mu.RLock()
 	defer mrl.mu.RUnlock()
 
-	status := make([]LimiterStatus, len(mrl.limiters))
-	for i, limiter := range mrl.limiters {
-		status[i] = LimiterStatus{
-			Limit:           limiter.limit,
-			Period:          limiter.period,
-			AvailableTokens: limiter.GetAvailableTokens(),
-		}
+	status := make([]LimiterStatus, len(mrl.tiers))
+	for i, tier := range mrl.tiers {
+		status[i] = tier.GetStatus()[0]
 	}
 	return status
 }
@@ -462,23 +769,385 @@ func (mrl *MultiRateLimiter) GetStatus() []LimiterStatus {
 func (mrl *MultiRateLimiter) TimeUntilReady() time.Duration {
 	mrl.mu.RLock()
 	defer mrl.mu.RUnlock()
+	defer mrl.lockAll()()
 
+	// All tiers are read at the same instant, including outstanding reservations
 	var maxWait time.Duration
-	for _, limiter := range mrl.limiters {
-		wait := limiter.TimeUntilNextToken()
-		if wait > maxWait {
-			maxWait = wait
-		}
+	for _, tier := range mrl.tiers {
+		maxWait = max(maxWait, tier.timeUntil(1))
 	}
 	return maxWait
 }
 
+// Reserve reserves tokens on all tiers without blocking and returns when they
+// may be used, see RateLimiter.Reserve.
+func (mrl *MultiRateLimiter) Reserve(tokens int) *Reservation {
+	mrl.mu.Lock()
+	defer mrl.mu.Unlock()
+
+	for _, limit := range mrl.limits {
+		if tokens > limit.Limit {
+			return &Reservation{}
+		}
+	}
+
+	unlock := mrl.lockAll()
+	delay, undo := reserveAll(mrl.tiers, tokens)
+	unlock()
+
+	undo = mrl.lockedUndo(undo)
+	return newReservation(mrl.clock, delay, func() {
+		mrl.mu.Lock()
+		defer mrl.mu.Unlock()
+		undo()
+	})
+}
+
 // Wait blocks until all limiters are ready or context is cancelled.
 func (mrl *MultiRateLimiter) Wait(ctx context.Context) error {
 	_, err := mrl.Acquire(ctx, 1, nil)
 	return err
 }
 
+// ============================================================================
+// SlidingWindowLimiter - Sliding Window Log
+// ============================================================================
+
+// SlidingWindowLimiter limits calls by counting their timestamps within a sliding window.
+//
+// Unlike the token bucket of RateLimiter, which refills continuously and can
+// let up to twice the limit through within one period after a burst, it never
+// allows more than limit calls within any period. Use it for strict quotas
+// such as "150 requests per minute" that the server enforces with a window.
+type SlidingWindowLimiter struct {
+	limit           int               // Maximum number of calls allowed in any period
+	period          time.Duration     // Length of the sliding window
+	onLimitExceeded RateLimitBehavior // Behavior when rate limit is exceeded
+	log             []time.Time       // Sorted times of granted and reserved calls in the window
+	clock           Clock             // Time source for the window and waits
+	queue           waitQueue         // Blocked acquisitions in priority order
+	mu              sync.Mutex        // Mutex for thread-safe operations
+}
+
+// NewSlidingWindowLimiter creates a new sliding window limiter.
+//
+// Args:
+//   - limit: Maximum number of calls allowed within any period
+//   - period: Length of the sliding window
+//   - onLimitExceeded: Behavior when rate limit is exceeded (default: RateLimitBlock)
+//   - opts: Optional settings such as WithClock
+//
+// Returns:
+//   - *SlidingWindowLimiter: A new sliding window limiter instance
+//   - error: Error if parameters are invalid
+func NewSlidingWindowLimiter(limit int, period time.Duration, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (*SlidingWindowLimiter, error) {
+	if limit <= 0 {
+		return nil, errors.New("limit must be positive")
+	}
+	if period <= 0 {
+		return nil, errors.New("period must be positive")
+	}
+
+	o := newLimiterOptions(opts)
+	return &SlidingWindowLimiter{
+		limit:           limit,
+		period:          period,
+		onLimitExceeded: onLimitExceeded,
+		clock:           o.clock,
+		queue:           waitQueue{clock: o.clock},
+	}, nil
+}
+
+// Acquire attempts to acquire tokens, one per call, from the window.
+//
+// Blocked acquisitions are queued and reserved like in RateLimiter.Acquire,
+// see WithPriority.
+func (sw *SlidingWindowLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error) {
+	behavior := sw.onLimitExceeded
+	if onLimitExceeded != nil {
+		behavior = *onLimitExceeded
+	}
+
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if sw.queue.empty() && sw.take(tokens) {
+		return true, nil
+	}
+
+	switch behavior {
+	case RateLimitBlock:
+		if tokens > sw.limit {
+			return false, fmt.Errorf("cannot acquire %d tokens from a limit of %d", tokens, sw.limit)
+		}
+		return sw.queue.wait(ctx, &sw.mu, func() (time.Duration, func(), bool) {
+			return reserveWhenDue([]rateTier{sw}, tokens)
+		})
+
+	case RateLimitRaise:
+		return false, ErrRateLimitExceeded
+
+	default: // RateLimitSkip
+		return false, nil
+	}
+}
+
+// TryAcquire attempts to acquire tokens without blocking.
+func (sw *SlidingWindowLimiter) TryAcquire(tokens int) bool {
+	skip := RateLimitSkip
+	acquired, _ := sw.Acquire(context.Background(), tokens, &skip)
+	return acquired
+}
+
+// Wait blocks until a call is allowed or the context is cancelled.
+func (sw *SlidingWindowLimiter) Wait(ctx context.Context) error {
+	_, err := sw.Acquire(ctx, 1, nil)
+	return err
+}
+
+// Reserve reserves tokens without blocking and returns when they may be used,
+// see RateLimiter.Reserve.
+func (sw *SlidingWindowLimiter) Reserve(tokens int) *Reservation {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	if tokens > sw.limit {
+		return &Reservation{}
+	}
+	delay, undo := sw.reserveNow(tokens)
+	return newReservation(sw.clock, delay, func() {
+		sw.mu.Lock()
+		defer sw.mu.Unlock()
+		undo()
+	})
+}
+
+// SetLimit changes the number of calls allowed per period at runtime.
+func (sw *SlidingWindowLimiter) SetLimit(limit int) error {
+	if limit <= 0 {
+		return errors.New("limit must be positive")
+	}
+
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.limit = limit
+	return nil
+}
+
+// SetPriorityAging sets the waiting time that raises a blocked acquisition by
+// one priority level, see WithPriority. Default: DefaultPriorityAging.
+func (sw *SlidingWindowLimiter) SetPriorityAging(aging time.Duration) {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.queue.setAging(aging)
+}
+
+// Reset forgets all calls in the window.
+func (sw *SlidingWindowLimiter) Reset() {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	sw.log = nil
+}
+
+// GetAvailableTokens returns the number of calls still allowed in the current window.
+func (sw *SlidingWindowLimiter) GetAvailableTokens() float64 {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.available()
+}
+
+// TimeUntilNextToken returns the time until the next call is allowed.
+func (sw *SlidingWindowLimiter) TimeUntilNextToken() time.Duration {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.timeUntil(1)
+}
+
+// GetStatus returns the status of the sliding window limiter.
+func (sw *SlidingWindowLimiter) GetStatus() []LimiterStatus {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+
+	return []LimiterStatus{{
+		Limit:           sw.limit,
+		Period:          sw.period,
+		AvailableTokens: sw.available(),
+	}}
+}
+
+// prune drops calls that left the window. The caller must hold the lock.
+func (sw *SlidingWindowLimiter) prune(now time.Time) {
+	cutoff := now.Add(-sw.period)
+	i := 0
+	for i < len(sw.log) && !sw.log[i].After(cutoff) {
+		i++
+	}
+	sw.log = sw.log[i:]
+}
+
+// due returns the earliest time at which tokens calls fit in the window,
+// after all granted and reserved calls. The caller must hold the lock.
+func (sw *SlidingWindowLimiter) due(now time.Time, tokens int) time.Time {
+	sw.prune(now)
+	t := now
+	if n := len(sw.log); n > 0 && sw.log[n-1].After(t) {
+		t = sw.log[n-1]
+	}
+	// The window ending at t must hold at most limit - tokens earlier calls
+	if k := len(sw.log) - (sw.limit - tokens); k > 0 {
+		if end := sw.log[k-1].Add(sw.period); end.After(t) {
+			t = end
+		}
+	}
+	return t
+}
+
+// record adds tokens calls at time t to the log. The caller must hold the lock.
+func (sw *SlidingWindowLimiter) record(t time.Time, tokens int) {
+	for range tokens {
+		sw.log = append(sw.log, t)
+	}
+}
+
+// take records tokens calls if they fit in the window now.
+// The caller must hold the lock.
+func (sw *SlidingWindowLimiter) take(tokens int) bool {
+	now := sw.clock.Now()
+	if sw.due(now, tokens).After(now) {
+		return false
+	}
+	sw.record(now, tokens)
+	return true
+}
+
+// pending returns the delay until the last reserved call is due, false if no
+// reservation is outstanding. The caller must hold the lock.
+func (sw *SlidingWindowLimiter) pending() (time.Duration, bool) {
+	now := sw.clock.Now()
+	if n := len(sw.log); n > 0 && sw.log[n-1].After(now) {
+		return sw.log[n-1].Sub(now), true
+	}
+	return 0, false
+}
+
+// reserveNow records tokens calls at the earliest time they fit in the window
+// and returns the delay until then and the function undoing the reservation.
+// The caller must hold the lock, also when undoing.
+func (sw *SlidingWindowLimiter) reserveNow(tokens int) (time.Duration, func()) {
+	now := sw.clock.Now()
+	t := sw.due(now, tokens)
+	sw.record(t, tokens)
+	return t.Sub(now), func() {
+		removed := 0
+		for i := len(sw.log) - 1; i >= 0 && removed < tokens; i-- {
+			if sw.log[i].Equal(t) {
+				sw.log = slices.Delete(sw.log, i, i+1)
+				removed++
+			}
+		}
+	}
+}
+
+// timeUntil returns the time until tokens calls fit in the window.
+// The caller must hold the lock.
+func (sw *SlidingWindowLimiter) timeUntil(tokens int) time.Duration {
+	now := sw.clock.Now()
+	return sw.due(now, tokens).Sub(now)
+}
+
+// available returns the number of calls still allowed in the current window,
+// counting reserved calls. The caller must hold the lock.
+func (sw *SlidingWindowLimiter) available() float64 {
+	sw.prune(sw.clock.Now())
+	return float64(max(0, sw.limit-len(sw.log)))
+}
+
+func (sw *SlidingWindowLimiter) lock()   { sw.mu.Lock() }
+func (sw *SlidingWindowLimiter) unlock() { sw.mu.Unlock() }
+
+// ============================================================================
+// Reservation
+// ============================================================================
+
+// Reservation holds tokens reserved by RateLimiter.Reserve or MultiRateLimiter.Reserve.
+type Reservation struct {
+	ok        bool
+	timeToAct time.Time
+	clock     Clock
+	unreserve func()
+
+	mu        sync.Mutex
+	cancelled bool
+}
+
+// newReservation creates an OK reservation ready after delay on the clock.
+func newReservation(clock Clock, delay time.Duration, unreserve func()) *Reservation {
+	return &Reservation{
+		ok:        true,
+		timeToAct: clock.Now().Add(delay),
+		clock:     clock,
+		unreserve: unreserve,
+	}
+}
+
+// OK reports whether the tokens were reserved. A reservation is not OK if it
+// asked for more tokens than the limit.
+func (r *Reservation) OK() bool {
+	return r.ok
+}
+
+// ReadyAt returns the time at which the reserved tokens may be used.
+func (r *Reservation) ReadyAt() time.Time {
+	return r.timeToAct
+}
+
+// Delay returns how long to wait before using the reserved tokens, 0 if they
+// may be used now.
+func (r *Reservation) Delay() time.Duration {
+	if !r.ok {
+		return 0
+	}
+	return r.DelayFrom(r.clock.Now())
+}
+
+// DelayFrom returns how long after now the reserved tokens may be used.
+func (r *Reservation) DelayFrom(now time.Time) time.Duration {
+	if !r.ok {
+		return 0
+	}
+	return max(0, r.timeToAct.Sub(now))
+}
+
+// Wait sleeps until the reservation is ready. If the context is done first,
+// the reservation is cancelled and the context error returned.
+func (r *Reservation) Wait(ctx context.Context) error {
+	if !r.ok {
+		return errors.New("reservation is not OK")
+	}
+	if err := sleepContext(ctx, r.clock, r.Delay()); err != nil {
+		r.Cancel()
+		return err
+	}
+	return nil
+}
+
+// Cancel returns the reserved tokens to the limiter. It has no effect once the
+// reservation is ready, or if it was already cancelled.
+func (r *Reservation) Cancel() {
+	if !r.ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.cancelled || !r.clock.Now().Before(r.timeToAct) {
+		return
+	}
+	r.cancelled = true
+	r.unreserve()
+}
+
 // ============================================================================
 // Helper Functions
 // ============================================================================
@@ -370,3 +370,260 @@ func BenchmarkRateLimiterParallel(b *testing.B) {
 		}
 	})
 }
+
+func TestSetLimit(t *testing.T) {
+	limiter, _ := NewRateLimiter(10, time.Second, RateLimitSkip)
+	if err := limiter.SetLimit(2); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := limiter.GetAvailableTokens(); tokens > 2 {
+		t.Errorf("Expected tokens capped at 2, got %f", tokens)
+	}
+	if err := limiter.SetLimit(0); err == nil {
+		t.Error("Expected error for non-positive limit")
+	}
+
+	limits := []RateLimit{{Limit: 30, Period: time.Second}, {Limit: 150, Period: time.Minute}}
+	multi, _ := NewMultiRateLimiter(limits, RateLimitSkip)
+	if err := multi.SetLimit(1, 60); err != nil {
+		t.Fatal(err)
+	}
+	if status := multi.GetStatus(); status[1].Limit != 60 || status[1].AvailableTokens > 60 {
+		t.Errorf("Unexpected status after SetLimit: %+v", status)
+	}
+	if limits[1].Limit != 150 {
+		t.Error("SetLimit modified the caller's limits")
+	}
+	if err := multi.SetLimit(2, 10); err == nil {
+		t.Error("Expected error for out of range index")
+	}
+}
+
+func TestBlockingAcquireNeverFails(t *testing.T) {
+	limiter, _ := NewRateLimiter(50, time.Second, RateLimitBlock)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	failures := make(chan string, 100)
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acquired, err := limiter.Acquire(ctx, 1, nil)
+			if err != nil || !acquired {
+				failures <- fmt.Sprintf("acquired=%v err=%v", acquired, err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(failures)
+
+	for failure := range failures {
+		t.Error(failure)
+	}
+}
+
+func TestMultiRateLimiterAtomic(t *testing.T) {
+	limiter, _ := NewMultiRateLimiter([]RateLimit{
+		{Limit: 20, Period: time.Hour},
+		{Limit: 10, Period: time.Hour},
+	}, RateLimitSkip)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	acquired := 0
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if limiter.TryAcquire(1) {
+				mu.Lock()
+				acquired++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if acquired != 10 {
+		t.Errorf("Expected 10 acquisitions, got %d", acquired)
+	}
+	// Refused acquisitions must not consume tokens from the looser tier
+	if status := limiter.GetStatus(); status[0].AvailableTokens < 9.99 || status[0].AvailableTokens > 10.01 {
+		t.Errorf("Expected 10 tokens left on the first tier, got %+v", status)
+	}
+}
+
+func TestCancelledWaitReturnsTokens(t *testing.T) {
+	limiter, _ := NewMultiRateLimiter([]RateLimit{
+		{Limit: 1, Period: time.Second},
+		{Limit: 5, Period: time.Minute},
+	}, RateLimitBlock)
+	limiter.TryAcquire(1)
+
+	// The waiter reserves the next token, then gives up before it is refilled
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.Acquire(ctx, 1, nil); err == nil {
+		t.Fatal("Expected context error")
+	}
+
+	status := limiter.GetStatus()
+	if status[1].AvailableTokens < 3.99 {
+		t.Errorf("Expected the reservation to be returned, got %+v", status)
+	}
+}
+
+func TestReserve(t *testing.T) {
+	limiter, _ := NewRateLimiter(10, time.Second, RateLimitSkip)
+	for limiter.TryAcquire(1) {
+	}
+
+	// Reservations queue behind each other: 100ms per token
+	first := limiter.Reserve(1)
+	second := limiter.Reserve(2)
+	if !first.OK() || !second.OK() {
+		t.Fatal("Expected OK reservations")
+	}
+	if d := first.Delay(); d < 90*time.Millisecond || d > 100*time.Millisecond {
+		t.Errorf("Expected first delay ~100ms, got %v", d)
+	}
+	if d := second.Delay(); d < 290*time.Millisecond || d > 300*time.Millisecond {
+		t.Errorf("Expected second delay ~300ms, got %v", d)
+	}
+	if d := limiter.TimeUntilNextToken(); d < 390*time.Millisecond {
+		t.Errorf("Expected next token after the reservations, got %v", d)
+	}
+
+	// Cancelling returns the tokens
+	second.Cancel()
+	second.Cancel()
+	if d := limiter.TimeUntilNextToken(); d > 200*time.Millisecond {
+		t.Errorf("Expected cancelled tokens to be returned, got %v", d)
+	}
+
+	if limiter.Reserve(11).OK() {
+		t.Error("Expected reservation above the limit to fail")
+	}
+
+	start := time.Now()
+	if err := first.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Expected Wait to sleep until ready, waited %v", elapsed)
+	}
+}
+
+func TestMultiRateLimiterReserve(t *testing.T) {
+	limiter, _ := NewMultiRateLimiter([]RateLimit{
+		{Limit: 30, Period: time.Second},
+		{Limit: 150, Period: time.Minute},
+	}, RateLimitSkip)
+
+	// Lay out 180 calls: the last 30 wait for the minute tier
+	var last *Reservation
+	for range 180 {
+		last = limiter.Reserve(1)
+	}
+	if d := last.Delay(); d < 11*time.Second || d > 12*time.Second {
+		t.Errorf("Expected last delay ~12s, got %v", d)
+	}
+	if d := limiter.TimeUntilReady(); d < 12*time.Second {
+		t.Errorf("Expected TimeUntilReady after the reservations, got %v", d)
+	}
+
+	last.Cancel()
+	if limiter.Reserve(31).OK() {
+		t.Error("Expected reservation above the limit to fail")
+	}
+}
+
+func TestSlidingWindowLimiter(t *testing.T) {
+	limiter, err := NewSlidingWindowLimiter(5, 200*time.Millisecond, RateLimitSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range 5 {
+		if !limiter.TryAcquire(1) {
+			t.Fatalf("Expected call %d to be allowed", i+1)
+		}
+	}
+	if limiter.TryAcquire(1) {
+		t.Fatal("Expected 6th call to be refused")
+	}
+
+	// A token bucket would have refilled half the limit by now
+	time.Sleep(100 * time.Millisecond)
+	if limiter.TryAcquire(1) {
+		t.Fatal("Expected calls to be refused until the window slides")
+	}
+
+	time.Sleep(110 * time.Millisecond)
+	if limiter.GetAvailableTokens() != 5 || !limiter.TryAcquire(1) {
+		t.Fatal("Expected calls to be allowed after the window slid")
+	}
+
+	// Blocking acquisition waits for the window
+	limiter.Reset()
+	ctx := context.Background()
+	block := RateLimitBlock
+	start := time.Now()
+	for range 6 {
+		if _, err := limiter.Acquire(ctx, 1, &block); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("Expected 6th call to wait for the window, waited %v", elapsed)
+	}
+}
+
+func TestSlidingWindowReserve(t *testing.T) {
+	limiter, _ := NewSlidingWindowLimiter(2, time.Second, RateLimitSkip)
+
+	first := limiter.Reserve(2)
+	second := limiter.Reserve(1)
+	if first.Delay() != 0 {
+		t.Errorf("Expected first reservation to be ready, got %v", first.Delay())
+	}
+	if d := second.Delay(); d < 990*time.Millisecond || d > time.Second {
+		t.Errorf("Expected second reservation after the window, got %v", d)
+	}
+
+	second.Cancel()
+	if d := limiter.TimeUntilNextToken(); d > time.Second {
+		t.Errorf("Expected cancelled call to leave the window, got %v", d)
+	}
+	if limiter.Reserve(3).OK() {
+		t.Error("Expected reservation above the limit to fail")
+	}
+}
+
+func TestMultiRateLimiterSlidingWindowTier(t *testing.T) {
+	limiter, err := NewMultiRateLimiter([]RateLimit{
+		{Limit: 30, Period: time.Second},
+		{Limit: 150, Period: time.Minute, Algorithm: RateLimitSlidingWindow},
+	}, RateLimitSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The 151st call waits for the first one to leave the minute window,
+	// where a token bucket would let it through after 0.4s
+	var last *Reservation
+	for range 151 {
+		last = limiter.Reserve(1)
+	}
+	if d := last.Delay(); d < 59*time.Second {
+		t.Errorf("Expected 151st call to wait for the window, got %v", d)
+	}
+	if status := limiter.GetStatus(); status[1].AvailableTokens != 0 {
+		t.Errorf("Expected the minute window to be full, got %+v", status)
+	}
+
+	if _, err := NewMultiRateLimiter([]RateLimit{{Limit: 1, Period: time.Second, Algorithm: "unknown"}}, RateLimitSkip); err == nil {
+		t.Error("Expected error for unknown algorithm")
+	}
+}
@@ -0,0 +1,188 @@
+package birdeye
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Retry policy for HTTPClient requests.
+//
+// Transient failures (network errors, 429 and 5xx responses) are retried with
+// exponential backoff and jitter. A Retry-After header sent by the server
+// replaces the computed backoff. Every wait honours the request context.
+
+// ============================================================================
+// RetryPolicy
+// ============================================================================
+
+// DefaultRetryableStatusCodes are the HTTP status codes retried when
+// RetryPolicy.RetryableStatusCodes is nil.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy controls how HTTPClient retries failed requests.
+//
+// Zero-valued fields are replaced by the values of DefaultRetryPolicy, so a
+// partially filled policy only overrides what it sets. To disable retries,
+// set MaxAttempts to 1.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Optional, default: 3
+	MaxAttempts int
+
+	// InitialBackoff is the wait before the first retry.
+	// Optional, default: 500ms
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the computed exponential backoff.
+	// Optional, default: 10s
+	MaxBackoff time.Duration
+
+	// Multiplier is the factor applied to the backoff after each attempt.
+	// Optional, default: 2
+	Multiplier float64
+
+	// Jitter is the fraction (0-1) of each backoff that is randomized.
+	// A jitter of 0.2 waits between 80% and 100% of the computed backoff.
+	// Optional, default: 0.2
+	Jitter float64
+
+	// NoJitter disables jitter, so that every backoff is exactly computed.
+	// Optional, default: false
+	NoJitter bool
+
+	// RetryableStatusCodes lists the HTTP status codes that are retried.
+	// If nil, DefaultRetryableStatusCodes is used. An empty, non-nil slice
+	// retries network errors only.
+	// Optional, default: nil (DefaultRetryableStatusCodes)
+	RetryableStatusCodes []int
+
+	// MaxRetryAfter is the longest Retry-After the client is willing to wait.
+	// If the server asks for a longer wait, the error is returned instead.
+	// Optional, default: 60s
+	MaxRetryAfter time.Duration
+
+	// IgnoreRetryAfter disables honouring the Retry-After response header.
+	// Optional, default: false
+	IgnoreRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when HTTPClientConfig.RetryPolicy is nil.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxRetryAfter:  time.Minute,
+	}
+}
+
+// withDefaults returns a copy of the policy with zero-valued fields filled in.
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	def := DefaultRetryPolicy()
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = def.MaxAttempts
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = def.InitialBackoff
+	}
+	if p.MaxBackoff <= 0 {
+		p.MaxBackoff = def.MaxBackoff
+	}
+	if p.Multiplier < 1 {
+		p.Multiplier = def.Multiplier
+	}
+	switch {
+	case p.NoJitter:
+		p.Jitter = 0
+	case p.Jitter <= 0:
+		p.Jitter = def.Jitter
+	default:
+		p.Jitter = math.Min(1, p.Jitter)
+	}
+	if p.RetryableStatusCodes == nil {
+		p.RetryableStatusCodes = DefaultRetryableStatusCodes
+	}
+	if p.MaxRetryAfter <= 0 {
+		p.MaxRetryAfter = def.MaxRetryAfter
+	}
+	return p
+}
+
+// isRetryableStatus reports whether the status code should be retried.
+func (p RetryPolicy) isRetryableStatus(statusCode int) bool {
+	return slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+// backoff returns the wait before the retry following the given attempt (1-based).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	d = math.Min(d, float64(p.MaxBackoff))
+	if p.Jitter > 0 {
+		d -= d * p.Jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// retryDelay returns the wait before retrying a response with a retryable status:
+// the Retry-After sent by the server if any, the computed backoff otherwise.
+// It returns false if the server asked for a wait longer than MaxRetryAfter.
+func (p RetryPolicy) retryDelay(attempt int, header http.Header, now time.Time) (time.Duration, bool) {
+	wait := p.backoff(attempt)
+	if p.IgnoreRetryAfter {
+		return wait, true
+	}
+	retryAfter, ok := parseRetryAfter(header.Get("Retry-After"), now)
+	if !ok {
+		return wait, true
+	}
+	if retryAfter > p.MaxRetryAfter {
+		return 0, false
+	}
+	return retryAfter, true
+}
+
+// parseRetryAfter parses a Retry-After header value given either as
+// delay-seconds or as an HTTP-date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// sleepContext waits on the clock for the given duration or until the context is done.
+func sleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
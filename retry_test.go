@@ -0,0 +1,190 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryOnRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte("<html>bad gateway</html>"))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"value":1.5}}`))
+	}, &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	price, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price.Value != 1.5 {
+		t.Fatalf("Expected price 1.5, got %f", price.Value)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("Expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"success":false,"message":"unavailable"}`))
+	}, &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+
+	_, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil)
+	var apiErr *BirdeyeAPIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected 503 BirdeyeAPIError, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("Expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetrySkipsNonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"success":false,"message":"bad address"}`))
+	}, &RetryPolicy{InitialBackoff: time.Millisecond})
+
+	if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err == nil {
+		t.Fatal("Expected error")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"message":"Too many requests"}`))
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+	}, &RetryPolicy{InitialBackoff: time.Millisecond})
+
+	start := time.Now()
+	if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected to wait for Retry-After, waited %v", elapsed)
+	}
+}
+
+func TestRetryAfterExceedsMax(t *testing.T) {
+	var calls atomic.Int32
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"success":false,"message":"Too many requests"}`))
+	}, &RetryPolicy{MaxRetryAfter: time.Second})
+
+	if _, err := client.GetTokenPrice(context.Background(), testTokenSOL, nil); err == nil {
+		t.Fatal("Expected error")
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("Expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestRetryBackoffRespectsContext(t *testing.T) {
+	client := newMockClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"success":false,"message":"internal"}`))
+	}, &RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetTokenPrice(ctx, testTokenSOL, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Backoff ignored context, waited %v", elapsed)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		NoJitter:       true,
+	}.withDefaults()
+
+	expected := []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+	}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want {
+			t.Errorf("attempt %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for range 100 {
+		if got := policy.backoff(1); got < 50*time.Millisecond || got > 100*time.Millisecond {
+			t.Fatalf("Jittered backoff out of range: %v", got)
+		}
+	}
+
+	// A zero jitter takes the default, like the other fields
+	if jitter := (RetryPolicy{MaxAttempts: 5}).withDefaults().Jitter; jitter != DefaultRetryPolicy().Jitter {
+		t.Errorf("Expected the default jitter, got %v", jitter)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Second, NoJitter: true}.withDefaults()
+	now := time.Now()
+
+	// Retry-After replaces the backoff, even when shorter
+	if d, ok := policy.retryDelay(1, http.Header{"Retry-After": {"2"}}, now); !ok || d != 2*time.Second {
+		t.Errorf("Expected the Retry-After wait, got %v, %v", d, ok)
+	}
+	if d, ok := policy.retryDelay(1, http.Header{}, now); !ok || d != 10*time.Second {
+		t.Errorf("Expected the backoff without Retry-After, got %v, %v", d, ok)
+	}
+	policy.IgnoreRetryAfter = true
+	if d, _ := policy.retryDelay(1, http.Header{"Retry-After": {"2"}}, now); d != 10*time.Second {
+		t.Errorf("Expected the backoff when ignoring Retry-After, got %v", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if d, ok := parseRetryAfter("7", now); !ok || d != 7*time.Second {
+		t.Errorf("Expected 7s, got %v (%v)", d, ok)
+	}
+	date := now.Add(30 * time.Second).Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date, now); !ok || d != 30*time.Second {
+		t.Errorf("Expected 30s, got %v (%v)", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon", now); ok {
+		t.Error("Expected invalid value to be rejected")
+	}
+}
@@ -0,0 +1,123 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+)
+
+// Resumable scroll iteration.
+//
+// GetTokenListV3Scroll returns a scroll ID with every page, to request the
+// next one with. ScrollTokenListV3 follows the scroll IDs until the list is
+// exhausted and keeps a ScrollCheckpoint up to date, which can be saved and
+// passed back to resume a crawl after a restart.
+
+// ============================================================================
+// Scroll Checkpoint
+// ============================================================================
+
+// ErrScrollExpired is returned when the API no longer accepts the scroll ID of
+// a checkpoint. The crawl has to restart from a zero checkpoint.
+var ErrScrollExpired = errors.New("scroll ID expired")
+
+// ScrollCheckpoint is the position of a scroll crawl. It serializes to JSON.
+type ScrollCheckpoint struct {
+	// ScrollID requests the next page, empty before the first page
+	ScrollID string `json:"scroll_id"`
+	// Items is the number of items of the pages consumed so far
+	Items int64 `json:"items"`
+	// Pages is the number of pages consumed so far
+	Pages int64 `json:"pages"`
+	// Done is set once the list is exhausted
+	Done bool `json:"done"`
+}
+
+// isScrollExpired reports whether err rejects the scroll ID of a request.
+func isScrollExpired(err error) bool {
+	var apiErr *BirdeyeAPIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusBadRequest, http.StatusNotFound, http.StatusGone:
+		return strings.Contains(strings.ToLower(apiErr.Message), "scroll")
+	}
+	return false
+}
+
+// ============================================================================
+// Scroll Iterator
+// ============================================================================
+
+// ScrollTokenListV3 iterates over the whole token list, following the scroll
+// IDs of GetTokenListV3Scroll until the list is exhausted.
+//
+// The crawl resumes from cp, which is updated each time a page has been
+// consumed; pass a zero checkpoint to start from the beginning, or nil if the
+// position is not needed. opts.ScrollID is ignored. A page interrupted by an
+// error, a break or a restart is requested again on resumption, so items may
+// be yielded more than once across runs.
+//
+// If the scroll ID of cp has expired, an error matching ErrScrollExpired is
+// yielded and cp is left unchanged. The scroll endpoint is limited to a few
+// requests per second, so a crawl is paced by its limiter; a request skipped
+// with RateLimitSkip yields an error matching ErrRateLimitExceeded and leaves
+// cp unchanged, so the crawl resumes from the skipped page.
+//
+// Example:
+//
+//	cp := loadCheckpoint() // birdeye.ScrollCheckpoint{} on the first run
+//	for token, err := range client.ScrollTokenListV3(ctx, nil, &cp) {
+//	    if errors.Is(err, birdeye.ErrScrollExpired) {
+//	        cp = birdeye.ScrollCheckpoint{} // restart the crawl
+//	        break
+//	    }
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    store(token)
+//	    saveCheckpoint(cp)
+//	}
+func (c *HTTPClient) ScrollTokenListV3(ctx context.Context, opts *TokenListV3ScrollOptions, cp *ScrollCheckpoint) iter.Seq2[RespTokenListV3TokenItem, error] {
+	if cp == nil {
+		cp = &ScrollCheckpoint{}
+	}
+	return func(yield func(RespTokenListV3TokenItem, error) bool) {
+		var o TokenListV3ScrollOptions
+		if opts != nil {
+			o = *opts
+		}
+
+		for !cp.Done {
+			o.ScrollID = cp.ScrollID
+			var skipped bool
+			page, err := c.GetTokenListV3Scroll(withSkipReport(ctx, &skipped), &o)
+			if err == nil && skipped {
+				err = fmt.Errorf("%w: page %d was skipped", ErrRateLimitExceeded, cp.Pages+1)
+			}
+			if err != nil {
+				if cp.ScrollID != "" && isScrollExpired(err) {
+					err = fmt.Errorf("%w after %d items: %w", ErrScrollExpired, cp.Items, err)
+				}
+				yield(RespTokenListV3TokenItem{}, err)
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next := page.NextCursor()
+			cp.ScrollID = next
+			cp.Items += int64(len(page.Items))
+			cp.Pages++
+			cp.Done = !page.HasNext || next == "" || len(page.Items) == 0
+		}
+	}
+}
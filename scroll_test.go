@@ -0,0 +1,188 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newScrollClient serves a token list of total items in pages of size,
+// encoding the next offset in the scroll ID. Scroll IDs listed in expired are
+// rejected.
+func newScrollClient(t *testing.T, total, size int, expired ...string) (*HTTPClient, *requestRecorder) {
+	t.Helper()
+	limiter, err := NewRateLimiter(1000, time.Second, RateLimitBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newScrollClientWith(t, HTTPClientConfig{
+		EndpointLimiters: map[string]Limiter{EndpointDefiV3TokenListScroll: limiter},
+	}, total, size, expired...)
+}
+
+// newScrollClientWith is newScrollClient with the client configured by config.
+func newScrollClientWith(t *testing.T, config HTTPClientConfig, total, size int, expired ...string) (*HTTPClient, *requestRecorder) {
+	t.Helper()
+	return newRecordingClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		scrollID := r.URL.Query().Get("scroll_id")
+		if slices.Contains(expired, scrollID) {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]any{"success": false, "message": "scroll_id is invalid or expired"})
+			return
+		}
+
+		offset := 0
+		if scrollID != "" {
+			offset, _ = strconv.Atoi(strings.TrimPrefix(scrollID, "scroll-"))
+		}
+		items := []map[string]any{}
+		for i := offset; i < offset+size && i < total; i++ {
+			items = append(items, map[string]any{"address": fmt.Sprintf("token-%d", i)})
+		}
+		next := offset + len(items)
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    map[string]any{"items": items, "hasNext": next < total, "next_scroll_id": fmt.Sprintf("scroll-%d", next)},
+		})
+	})
+}
+
+func TestScrollTokenListV3(t *testing.T) {
+	client, rec := newScrollClient(t, 25, 10)
+
+	var cp ScrollCheckpoint
+	var addresses []string
+	for token, err := range client.ScrollTokenListV3(context.Background(), &TokenListV3ScrollOptions{Limit: 10}, &cp) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		addresses = append(addresses, token.Address)
+	}
+	if len(addresses) != 25 || addresses[0] != "token-0" || addresses[24] != "token-24" {
+		t.Fatalf("unexpected tokens: %v", addresses)
+	}
+	if want := []string{"", "scroll-10", "scroll-20"}; fmt.Sprint(rec.query("", "scroll_id")) != fmt.Sprint(want) {
+		t.Errorf("requested scroll IDs %v, want %v", rec.query("", "scroll_id"), want)
+	}
+	if want := (ScrollCheckpoint{ScrollID: "scroll-25", Items: 25, Pages: 3, Done: true}); cp != want {
+		t.Errorf("checkpoint %+v, want %+v", cp, want)
+	}
+
+	// A finished crawl requests nothing more
+	for range client.ScrollTokenListV3(context.Background(), nil, &cp) {
+		t.Fatal("finished crawl yielded an item")
+	}
+	if n := len(rec.query("", "scroll_id")); n != 3 {
+		t.Errorf("%d requests after finishing, want 3", n)
+	}
+}
+
+func TestScrollTokenListV3Resume(t *testing.T) {
+	client, rec := newScrollClient(t, 25, 10)
+	ctx := context.Background()
+
+	// Break in the middle of the second page
+	var cp ScrollCheckpoint
+	n := 0
+	for _, err := range client.ScrollTokenListV3(ctx, &TokenListV3ScrollOptions{Limit: 10}, &cp) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n++; n == 15 {
+			break
+		}
+	}
+	if want := (ScrollCheckpoint{ScrollID: "scroll-10", Items: 10, Pages: 1}); cp != want {
+		t.Fatalf("checkpoint %+v, want %+v", cp, want)
+	}
+
+	// Round-trip the checkpoint and resume from the interrupted page
+	data, err := json.Marshal(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restored ScrollCheckpoint
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatal(err)
+	}
+	var addresses []string
+	for token, err := range client.ScrollTokenListV3(ctx, &TokenListV3ScrollOptions{Limit: 10}, &restored) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		addresses = append(addresses, token.Address)
+	}
+	if len(addresses) != 15 || addresses[0] != "token-10" || addresses[14] != "token-24" {
+		t.Fatalf("unexpected resumed tokens: %v", addresses)
+	}
+	if !restored.Done || restored.Items != 25 {
+		t.Errorf("unexpected final checkpoint %+v", restored)
+	}
+	if want := []string{"", "scroll-10", "scroll-10", "scroll-20"}; fmt.Sprint(rec.query("", "scroll_id")) != fmt.Sprint(want) {
+		t.Errorf("requested scroll IDs %v, want %v", rec.query("", "scroll_id"), want)
+	}
+}
+
+func TestScrollTokenListV3Expired(t *testing.T) {
+	client, _ := newScrollClient(t, 25, 10, "scroll-10")
+
+	cp := ScrollCheckpoint{ScrollID: "scroll-10", Items: 10, Pages: 1}
+	var gotErr error
+	for _, err := range client.ScrollTokenListV3(context.Background(), nil, &cp) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		t.Fatal("expired scroll yielded an item")
+	}
+	if !errors.Is(gotErr, ErrScrollExpired) {
+		t.Fatalf("expected ErrScrollExpired, got %v", gotErr)
+	}
+	var apiErr *BirdeyeAPIError
+	if !errors.As(gotErr, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("expected the API error to be wrapped, got %v", gotErr)
+	}
+	if want := (ScrollCheckpoint{ScrollID: "scroll-10", Items: 10, Pages: 1}); cp != want {
+		t.Errorf("checkpoint changed to %+v", cp)
+	}
+
+	// The same rejection of a fresh crawl is not an expiry
+	client, _ = newScrollClient(t, 25, 10, "")
+	for _, err := range client.ScrollTokenListV3(context.Background(), nil, nil) {
+		if errors.Is(err, ErrScrollExpired) {
+			t.Errorf("fresh crawl reported an expired scroll: %v", err)
+		}
+		break
+	}
+}
+
+func TestScrollTokenListV3Skipped(t *testing.T) {
+	client, rec := newScrollClientWith(t, skippingConfig(t, EndpointDefiV3TokenListScroll, 1), 25, 10)
+
+	var cp ScrollCheckpoint
+	count := 0
+	var gotErr error
+	for _, err := range client.ScrollTokenListV3(context.Background(), &TokenListV3ScrollOptions{Limit: 10}, &cp) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		count++
+	}
+	if count != 10 || !errors.Is(gotErr, ErrRateLimitExceeded) {
+		t.Fatalf("expected 10 tokens then ErrRateLimitExceeded, got %d and %v", count, gotErr)
+	}
+	if want := (ScrollCheckpoint{ScrollID: "scroll-10", Items: 10, Pages: 1}); cp != want {
+		t.Errorf("checkpoint %+v, want %+v", cp, want)
+	}
+	if rec.count() != 1 {
+		t.Errorf("%d requests, want 1", rec.count())
+	}
+}
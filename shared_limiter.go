@@ -0,0 +1,310 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Rate limiting shared between processes.
+//
+// The built-in limiters keep their buckets in memory, so several processes
+// using one API key each get the full quota. A SharedLimiterBackend stores the
+// buckets outside the limiter instead: every BackendLimiter, in this process or
+// another, that uses the same backend and key draws from the same tokens.
+//
+// MemoryLimiterBackend shares buckets within a process, FileLimiterBackend
+// between processes on one host. Networked stores (e.g. Redis) can be plugged
+// in by implementing SharedLimiterBackend.
+
+// ============================================================================
+// SharedLimiterBackend Interface
+// ============================================================================
+
+// SharedLimiterBackend stores token buckets shared by several limiters.
+//
+// Implementations must be safe for concurrent use, also from other processes
+// if they share state with them.
+type SharedLimiterBackend interface {
+	// Take atomically refills the token buckets of key up to now and takes
+	// tokens from every one of them, or from none.
+	//
+	// key identifies a set of buckets, one per limit, which are token buckets
+	// regardless of their Algorithm. Buckets are created full on first use.
+	//
+	// It returns 0 if the tokens were taken, otherwise the time until every
+	// bucket holds enough tokens.
+	Take(ctx context.Context, key string, limits []RateLimit, tokens int, now time.Time) (time.Duration, error)
+}
+
+var (
+	_ SharedLimiterBackend = (*MemoryLimiterBackend)(nil)
+	_ SharedLimiterBackend = (*FileLimiterBackend)(nil)
+	_ Limiter              = (*BackendLimiter)(nil)
+)
+
+// ============================================================================
+// Token Buckets
+// ============================================================================
+
+// bucketState is the stored state of a token bucket.
+type bucketState struct {
+	tokens float64
+	last   time.Time // Last refill, zero for a bucket never used
+}
+
+// refill adds the tokens accumulated since the last refill.
+func (b *bucketState) refill(limit RateLimit, now time.Time) {
+	if b.last.IsZero() {
+		b.tokens = float64(limit.Limit)
+		b.last = now
+		return
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * float64(limit.Limit) / limit.Period.Seconds()
+		b.last = now
+	}
+	b.tokens = min(float64(limit.Limit), b.tokens)
+}
+
+// takeBuckets refills the buckets and takes tokens from all of them if each
+// holds enough. It returns 0 on success, otherwise the time until they do.
+func takeBuckets(buckets []*bucketState, limits []RateLimit, tokens int, now time.Time) time.Duration {
+	var wait time.Duration
+	for i, b := range buckets {
+		b.refill(limits[i], now)
+		if missing := float64(tokens) - b.tokens; missing > 0 {
+			d := time.Duration(missing * limits[i].Period.Seconds() / float64(limits[i].Limit) * float64(time.Second))
+			wait = max(wait, max(d, time.Nanosecond))
+		}
+	}
+	if wait > 0 {
+		return wait
+	}
+	for _, b := range buckets {
+		b.tokens -= float64(tokens)
+	}
+	return 0
+}
+
+// validateTake checks the arguments of SharedLimiterBackend.Take.
+func validateTake(limits []RateLimit, tokens int) error {
+	if len(limits) == 0 {
+		return errors.New("at least one rate limit must be specified")
+	}
+	for _, limit := range limits {
+		if limit.Limit <= 0 || limit.Period <= 0 {
+			return errors.New("limit and period must be positive")
+		}
+		if tokens > limit.Limit {
+			return fmt.Errorf("cannot acquire %d tokens from a limit of %d", tokens, limit.Limit)
+		}
+	}
+	return nil
+}
+
+// bucketKey returns the key of the bucket enforcing one limit of a limiter.
+func bucketKey(key string, index int, limit RateLimit) string {
+	return fmt.Sprintf("%s#%d/%s", key, index, limit.Period)
+}
+
+// ============================================================================
+// MemoryLimiterBackend
+// ============================================================================
+
+// MemoryLimiterBackend keeps shared token buckets in memory.
+//
+// It shares a quota between limiters and clients of one process, and serves as
+// a reference for implementing other backends.
+type MemoryLimiterBackend struct {
+	mu      sync.Mutex
+	buckets map[string]*bucketState
+}
+
+// NewMemoryLimiterBackend creates an empty in-memory backend.
+func NewMemoryLimiterBackend() *MemoryLimiterBackend {
+	return &MemoryLimiterBackend{buckets: make(map[string]*bucketState)}
+}
+
+// Take takes tokens from the buckets of key, see SharedLimiterBackend.
+func (m *MemoryLimiterBackend) Take(ctx context.Context, key string, limits []RateLimit, tokens int, now time.Time) (time.Duration, error) {
+	if err := validateTake(limits, tokens); err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	buckets := make([]*bucketState, len(limits))
+	for i, limit := range limits {
+		k := bucketKey(key, i, limit)
+		if m.buckets[k] == nil {
+			m.buckets[k] = &bucketState{}
+		}
+		buckets[i] = m.buckets[k]
+	}
+	return takeBuckets(buckets, limits, tokens, now), nil
+}
+
+// ============================================================================
+// FileLimiterBackend
+// ============================================================================
+
+// fileLimiterSlots is the number of buckets a FileLimiterBackend file holds.
+const fileLimiterSlots = 1024
+
+// ErrLimiterFileFull is returned when a FileLimiterBackend file has no free bucket slot left.
+var ErrLimiterFileFull = errors.New("limiter file has no free bucket slot")
+
+// bucketHash returns the non-zero hash identifying a bucket in a limiter file.
+func bucketHash(key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	if sum := h.Sum64(); sum != 0 {
+		return sum
+	}
+	return 1
+}
+
+// ============================================================================
+// BackendLimiter
+// ============================================================================
+
+// BackendLimiter is a Limiter whose token buckets live in a SharedLimiterBackend.
+//
+// Limiters using the same backend and key share their tokens, across processes
+// if the backend does. Unlike RateLimiter, blocked acquisitions poll the backend
+// instead of queueing, so priorities (WithPriority) are not applied.
+type BackendLimiter struct {
+	backend         SharedLimiterBackend
+	key             string
+	onLimitExceeded RateLimitBehavior
+	clock           Clock
+
+	mu     sync.RWMutex
+	limits []RateLimit
+}
+
+// NewBackendLimiter creates a limiter drawing from the shared buckets of key.
+//
+// Args:
+//   - backend: Store of the shared token buckets
+//   - key: Identifies the buckets, limiters with the same key share them
+//   - limits: Rate limits enforced, each by a token bucket
+//   - onLimitExceeded: Behavior when rate limit is exceeded (default: RateLimitBlock)
+//   - opts: Optional settings such as WithClock
+//
+// Example:
+//
+//	backend, _ := birdeye.NewFileLimiterBackend("/tmp/birdeye.limits")
+//	defer backend.Close()
+//
+//	// In every worker process
+//	limiter, _ := birdeye.NewBackendLimiter(backend, "wallet",
+//	    []birdeye.RateLimit{{Limit: 30, Period: time.Second}, {Limit: 150, Period: time.Minute}},
+//	    birdeye.RateLimitBlock)
+func NewBackendLimiter(backend SharedLimiterBackend, key string, limits []RateLimit, onLimitExceeded RateLimitBehavior, opts ...LimiterOption) (*BackendLimiter, error) {
+	if backend == nil {
+		return nil, errors.New("backend must not be nil")
+	}
+	if err := validateTake(limits, 1); err != nil {
+		return nil, err
+	}
+
+	o := newLimiterOptions(opts)
+	return &BackendLimiter{
+		backend:         backend,
+		key:             key,
+		onLimitExceeded: onLimitExceeded,
+		clock:           o.clock,
+		limits:          slices.Clone(limits),
+	}, nil
+}
+
+// Acquire attempts to acquire tokens from the shared buckets.
+//
+// In RateLimitBlock mode it sleeps until the backend reports the tokens
+// available and tries again, until it succeeds or the context is done.
+func (bl *BackendLimiter) Acquire(ctx context.Context, tokens int, onLimitExceeded *RateLimitBehavior) (bool, error) {
+	behavior := bl.onLimitExceeded
+	if onLimitExceeded != nil {
+		behavior = *onLimitExceeded
+	}
+
+	for {
+		wait, err := bl.backend.Take(ctx, bl.key, bl.currentLimits(), tokens, bl.clock.Now())
+		if err != nil {
+			return false, err
+		}
+		if wait <= 0 {
+			return true, nil
+		}
+
+		switch behavior {
+		case RateLimitBlock:
+			if err := sleepContext(ctx, bl.clock, wait); err != nil {
+				return false, err
+			}
+		case RateLimitRaise:
+			return false, ErrRateLimitExceeded
+		default: // RateLimitSkip
+			return false, nil
+		}
+	}
+}
+
+// TryAcquire attempts to acquire tokens without blocking.
+func (bl *BackendLimiter) TryAcquire(tokens int) bool {
+	skip := RateLimitSkip
+	acquired, _ := bl.Acquire(context.Background(), tokens, &skip)
+	return acquired
+}
+
+// Wait blocks until a token is available or the context is cancelled.
+func (bl *BackendLimiter) Wait(ctx context.Context) error {
+	_, err := bl.Acquire(ctx, 1, nil)
+	return err
+}
+
+// SetLimit changes the limit of one tier for this limiter at runtime.
+// Other limiters sharing the buckets keep their own limits.
+//
+// Args:
+//   - index: Position of the tier in the limits passed to NewBackendLimiter
+//   - limit: New number of calls allowed per period of that tier
+func (bl *BackendLimiter) SetLimit(index, limit int) error {
+	if limit <= 0 {
+		return errors.New("limit must be positive")
+	}
+
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	if index < 0 || index >= len(bl.limits) {
+		return fmt.Errorf("rate limit index %d out of range", index)
+	}
+	bl.limits[index].Limit = limit
+	return nil
+}
+
+// GetStatus returns the configured limits. The available tokens are not read
+// from the backend and reported as 0.
+func (bl *BackendLimiter) GetStatus() []LimiterStatus {
+	limits := bl.currentLimits()
+	status := make([]LimiterStatus, len(limits))
+	for i, limit := range limits {
+		status[i] = LimiterStatus{Limit: limit.Limit, Period: limit.Period}
+	}
+	return status
+}
+
+// currentLimits returns a copy of the limits.
+func (bl *BackendLimiter) currentLimits() []RateLimit {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+	return slices.Clone(bl.limits)
+}
@@ -0,0 +1,32 @@
+//go:build !unix
+
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// errFileLimiterUnsupported is returned by FileLimiterBackend on platforms without flock and mmap.
+var errFileLimiterUnsupported = errors.New("file limiter backend is not supported on this platform")
+
+// FileLimiterBackend keeps shared token buckets in a memory-mapped file.
+//
+// It requires flock and mmap and is only available on unix platforms.
+type FileLimiterBackend struct{}
+
+// NewFileLimiterBackend returns an error on this platform.
+func NewFileLimiterBackend(path string) (*FileLimiterBackend, error) {
+	return nil, errFileLimiterUnsupported
+}
+
+// Take returns an error on this platform.
+func (f *FileLimiterBackend) Take(ctx context.Context, key string, limits []RateLimit, tokens int, now time.Time) (time.Duration, error) {
+	return 0, errFileLimiterUnsupported
+}
+
+// Close does nothing on this platform.
+func (f *FileLimiterBackend) Close() error {
+	return nil
+}
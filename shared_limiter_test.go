@@ -0,0 +1,153 @@
+package birdeye
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiterBackend(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	backend := NewMemoryLimiterBackend()
+	limits := []RateLimit{{Limit: 5, Period: time.Second}, {Limit: 8, Period: time.Minute}}
+
+	a, _ := NewBackendLimiter(backend, "key", limits, RateLimitSkip, WithClock(clock))
+	b, _ := NewBackendLimiter(backend, "key", limits, RateLimitSkip, WithClock(clock))
+	other, _ := NewBackendLimiter(backend, "other", limits, RateLimitSkip, WithClock(clock))
+
+	if !a.TryAcquire(3) || !b.TryAcquire(2) {
+		t.Fatal("Expected 5 tokens shared by both limiters")
+	}
+	if a.TryAcquire(1) || b.TryAcquire(1) {
+		t.Fatal("Expected the shared bucket to be empty")
+	}
+	if !other.TryAcquire(5) {
+		t.Fatal("Expected a different key to have its own buckets")
+	}
+
+	// The per-second bucket refills, the per-minute one holds 3 tokens
+	clock.Advance(time.Second)
+	if b.TryAcquire(4) {
+		t.Fatal("Expected the minute tier to refuse 4 tokens without charging the second tier")
+	}
+	if !b.TryAcquire(3) {
+		t.Fatal("Expected 3 tokens after a second")
+	}
+
+	if _, err := a.Acquire(context.Background(), 6, nil); err == nil {
+		t.Fatal("Expected error for more tokens than the limit")
+	}
+}
+
+func TestBackendLimiterBlock(t *testing.T) {
+	clock := NewManualClock(time.Now())
+	limiter, _ := NewBackendLimiter(NewMemoryLimiterBackend(), "key",
+		[]RateLimit{{Limit: 2, Period: time.Second}}, RateLimitBlock, WithClock(clock))
+	limiter.TryAcquire(2)
+
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(context.Background()) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(500 * time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	raise := RateLimitRaise
+	if _, err := limiter.Acquire(context.Background(), 1, &raise); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected ErrRateLimitExceeded, got %v", err)
+	}
+}
+
+func TestFileLimiterBackend(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file limiter backend requires unix")
+	}
+
+	path := filepath.Join(t.TempDir(), "birdeye.limits")
+	now := time.Now()
+	limits := []RateLimit{{Limit: 3, Period: time.Minute}}
+
+	// Two backends on one file stand in for two processes
+	first, err := NewFileLimiterBackend(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := NewFileLimiterBackend(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+
+	ctx := context.Background()
+	for i, backend := range []*FileLimiterBackend{first, second, first} {
+		if wait, err := backend.Take(ctx, "key", limits, 1, now); err != nil || wait != 0 {
+			t.Fatalf("Take %d: expected a token, got wait %v, err %v", i, wait, err)
+		}
+	}
+	wait, err := second.Take(ctx, "key", limits, 1, now)
+	if err != nil || wait != 20*time.Second {
+		t.Fatalf("Expected to wait 20s for the next token, got %v, %v", wait, err)
+	}
+
+	// The buckets outlive the backend that wrote them
+	first.Close()
+	if _, err := first.Take(ctx, "key", limits, 1, now); err == nil {
+		t.Fatal("Expected error after Close")
+	}
+	reopened, err := NewFileLimiterBackend(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+	if wait, _ := reopened.Take(ctx, "key", limits, 1, now.Add(20*time.Second)); wait != 0 {
+		t.Fatalf("Expected a refilled token, got wait %v", wait)
+	}
+
+	invalid := filepath.Join(t.TempDir(), "invalid")
+	os.WriteFile(invalid, []byte("not a limiter file"), 0o600)
+	if _, err := NewFileLimiterBackend(invalid); err == nil {
+		t.Fatal("Expected error for a foreign file")
+	}
+}
+
+func TestHTTPClientSharedLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"value":1}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	clock := NewManualClock(time.Now())
+	backend := NewMemoryLimiterBackend()
+	profile := &RateLimitProfile{Categories: map[EndpointCategory][]RateLimit{
+		EndpointCategoryMarketData: {{Limit: 2, Period: time.Second}},
+	}}
+	newClient := func() *HTTPClient {
+		return NewHTTPClient(HTTPClientConfig{
+			APIKey:               "test-key",
+			BaseURL:              server.URL,
+			OnLimitExceeded:      RateLimitRaise,
+			RateLimitProfile:     profile,
+			SharedLimiterBackend: backend,
+			Clock:                clock,
+		})
+	}
+	first, second := newClient(), newClient()
+
+	ctx := context.Background()
+	for _, client := range []*HTTPClient{first, second} {
+		if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := first.GetTokenPrice(ctx, testTokenSOL, nil); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("Expected the shared quota to be exhausted, got %v", err)
+	}
+}
@@ -0,0 +1,180 @@
+//go:build unix
+
+package birdeye
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	fileLimiterMagic    = "BEYELIM1"
+	fileLimiterSlotSize = 24 // Bucket hash, tokens, last refill in unix nanoseconds
+	fileLimiterSize     = len(fileLimiterMagic) + fileLimiterSlots*fileLimiterSlotSize
+)
+
+// FileLimiterBackend keeps shared token buckets in a memory-mapped file.
+//
+// Processes on one host that open the same file share the buckets. Every Take
+// holds an exclusive flock on the file, so it is atomic across processes.
+// The file holds up to 1024 buckets, one per limit of each key.
+type FileLimiterBackend struct {
+	mu   sync.Mutex // flock does not exclude goroutines sharing the descriptor
+	file *os.File
+	data []byte
+}
+
+// NewFileLimiterBackend opens or creates the limiter file at path and maps it into memory.
+//
+// Example:
+//
+//	backend, err := birdeye.NewFileLimiterBackend(filepath.Join(os.TempDir(), "birdeye.limits"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer backend.Close()
+//
+//	client := birdeye.NewHTTPClient(birdeye.HTTPClientConfig{
+//	    APIKey:               "your-api-key",
+//	    SharedLimiterBackend: backend,
+//	})
+func NewFileLimiterBackend(path string) (*FileLimiterBackend, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := mapLimiterFile(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &FileLimiterBackend{file: file, data: data}, nil
+}
+
+// mapLimiterFile sizes and initializes the file under its lock and maps it.
+func mapLimiterFile(file *os.File) ([]byte, error) {
+	fd := int(file.Fd())
+	if err := syscall.Flock(fd, syscall.LOCK_EX); err != nil {
+		return nil, err
+	}
+	defer syscall.Flock(fd, syscall.LOCK_UN)
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() < int64(fileLimiterSize) {
+		if err := file.Truncate(int64(fileLimiterSize)); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := syscall.Mmap(fd, 0, fileLimiterSize, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+
+	switch magic := string(data[:len(fileLimiterMagic)]); magic {
+	case fileLimiterMagic:
+	case string(make([]byte, len(fileLimiterMagic))):
+		copy(data, fileLimiterMagic)
+	default:
+		syscall.Munmap(data)
+		return nil, fmt.Errorf("%s is not a limiter file", file.Name())
+	}
+	return data, nil
+}
+
+// Take takes tokens from the buckets of key, see SharedLimiterBackend.
+func (f *FileLimiterBackend) Take(ctx context.Context, key string, limits []RateLimit, tokens int, now time.Time) (time.Duration, error) {
+	if err := validateTake(limits, tokens); err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.data == nil {
+		return 0, errors.New("limiter file is closed")
+	}
+	fd := int(f.file.Fd())
+	if err := syscall.Flock(fd, syscall.LOCK_EX); err != nil {
+		return 0, err
+	}
+	defer syscall.Flock(fd, syscall.LOCK_UN)
+
+	slots := make([][]byte, len(limits))
+	buckets := make([]*bucketState, len(limits))
+	for i, limit := range limits {
+		slot, err := f.slot(bucketHash(bucketKey(key, i, limit)))
+		if err != nil {
+			return 0, err
+		}
+		slots[i] = slot
+		buckets[i] = readBucket(slot)
+	}
+
+	wait := takeBuckets(buckets, limits, tokens, now)
+	for i, slot := range slots {
+		writeBucket(slot, buckets[i])
+	}
+	return wait, nil
+}
+
+// slot returns the slot of a bucket, claiming a free one for a new bucket.
+// The caller must hold the file lock.
+func (f *FileLimiterBackend) slot(hash uint64) ([]byte, error) {
+	start := int(hash % fileLimiterSlots)
+	for i := range fileLimiterSlots {
+		offset := len(fileLimiterMagic) + (start+i)%fileLimiterSlots*fileLimiterSlotSize
+		slot := f.data[offset : offset+fileLimiterSlotSize]
+		switch binary.LittleEndian.Uint64(slot) {
+		case hash:
+			return slot, nil
+		case 0:
+			clear(slot)
+			binary.LittleEndian.PutUint64(slot, hash)
+			return slot, nil
+		}
+	}
+	return nil, ErrLimiterFileFull
+}
+
+// Close unmaps and closes the limiter file. The buckets stay in the file.
+func (f *FileLimiterBackend) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.data == nil {
+		return nil
+	}
+	err := syscall.Munmap(f.data)
+	f.data = nil
+	return errors.Join(err, f.file.Close())
+}
+
+// readBucket decodes the bucket stored in a slot.
+func readBucket(slot []byte) *bucketState {
+	b := &bucketState{tokens: math.Float64frombits(binary.LittleEndian.Uint64(slot[8:]))}
+	if last := int64(binary.LittleEndian.Uint64(slot[16:])); last != 0 {
+		b.last = time.Unix(0, last)
+	}
+	return b
+}
+
+// writeBucket encodes a bucket into its slot.
+func writeBucket(slot []byte, b *bucketState) {
+	binary.LittleEndian.PutUint64(slot[8:], math.Float64bits(b.tokens))
+	binary.LittleEndian.PutUint64(slot[16:], uint64(b.last.UnixNano()))
+}
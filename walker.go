@@ -0,0 +1,290 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+)
+
+// Time-cursor walkers.
+//
+// Offset pagination stops at the pagination window, so the history of a busy
+// token cannot be read by offset alone. The Walk* methods page through one
+// window by offset, then slide the time (or block number) bound of the query
+// past the last item seen and start over at offset 0, until the history is
+// exhausted.
+//
+// Windows overlap around the boundary second or block, so items seen twice
+// are skipped, identified by transaction hash and instruction indexes. With
+// sort type "desc" (the default) the walk goes back in time by moving
+// BeforeTime; with "asc" it goes forward by moving AfterTime.
+//
+// A second or block holding more items than the window cannot be read in
+// full. The walk then yields an error matching ErrPaginationWindow before
+// stepping past it; unlike other errors, it ends the walk only if the loop
+// stops.
+
+// ============================================================================
+// Walker
+// ============================================================================
+
+// txKey identifies a transaction item across windows.
+type txKey struct {
+	hash          string
+	insIndex      int64
+	innerInsIndex int64
+	detail        string // Distinguishes items of endpoints without instruction indexes
+}
+
+// txCursor holds the option fields moved by a walker.
+type txCursor struct {
+	offset, limit *int64
+	before, after *int64 // Time or block number bounds the cursor value is compared to
+	ascending     bool
+}
+
+// walkTxs iterates over the items of consecutive windows, moving the cursor
+// bound to the value of the last item of each window.
+//
+// cursor returns the fields of the copied options, fetch requests the page
+// they select with the given context, key identifies an item and value
+// returns its cursor value. A page skipped by the rate limiter yields an error
+// matching ErrRateLimitExceeded.
+func walkTxs[O, T any](
+	ctx context.Context,
+	opts *O,
+	window int64,
+	cursor func(*O) txCursor,
+	fetch func(context.Context, *O) ([]T, bool, error),
+	key func(T) txKey,
+	value func(T) int64,
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		var o O
+		if opts != nil {
+			o = *opts
+		}
+		if _, err := ApplyDefaultsAndBuildParams(&o); err != nil {
+			yield(zero, fmt.Errorf("failed to apply defaults: %w", err))
+			return
+		}
+		cur := cursor(&o)
+		pageLimit := *cur.limit
+
+		// Items within one step of the edge, the cursor value reached so far.
+		// The next window starts there whether the API bounds are inclusive
+		// or exclusive.
+		var edge int64
+		started := false
+		seen := make(map[txKey]int64)
+		beyond := func(v int64) bool {
+			if cur.ascending {
+				return v > edge
+			}
+			return v < edge
+		}
+		distant := func(_ txKey, v int64) bool {
+			if cur.ascending {
+				return v < edge-1
+			}
+			return v > edge+1
+		}
+
+		strict := false
+		for {
+			// Page through the window
+			fresh := 0
+			*cur.limit = pageLimit
+			for {
+				if *cur.offset+*cur.limit > window {
+					*cur.limit = window - *cur.offset
+				}
+				var skipped bool
+				items, hasNext, err := fetch(withSkipReport(ctx, &skipped), &o)
+				if err == nil && skipped {
+					err = fmt.Errorf("%w: page at offset %d was skipped", ErrRateLimitExceeded, *cur.offset)
+				}
+				if err != nil {
+					yield(zero, err)
+					return
+				}
+
+				for _, item := range items {
+					k, v := key(item), value(item)
+					if _, dup := seen[k]; dup {
+						continue
+					}
+					if !started || beyond(v) {
+						edge, started = v, true
+						maps.DeleteFunc(seen, distant)
+					}
+					seen[k] = v
+					fresh++
+					if !yield(item, nil) {
+						return
+					}
+				}
+
+				if !hasNext || len(items) == 0 {
+					return
+				}
+				*cur.offset += int64(len(items))
+				if *cur.offset >= window {
+					break
+				}
+			}
+
+			// A window holding only the edge value cannot move the cursor:
+			// skip past it, reporting the items left behind, then give up if
+			// that still yields nothing new
+			if fresh == 0 {
+				if strict {
+					return
+				}
+				strict = true
+				err := fmt.Errorf("%w: more than %d items at %d, skipping past it", ErrPaginationWindow, window, edge)
+				if !yield(zero, err) {
+					return
+				}
+			} else {
+				strict = false
+			}
+
+			// Slide the bound to the edge, inclusive unless strict
+			*cur.offset = 0
+			switch {
+			case cur.ascending && strict:
+				*cur.after = edge
+			case cur.ascending:
+				*cur.after = edge - 1
+			case strict:
+				*cur.before = edge
+			default:
+				*cur.before = edge + 1
+			}
+		}
+	}
+}
+
+// v3TxKey identifies a v3 transaction item.
+func v3TxKey(hash string, insIndex, innerInsIndex int64) txKey {
+	return txKey{hash: hash, insIndex: insIndex, innerInsIndex: innerInsIndex}
+}
+
+// tradeTxKey identifies a transaction item of an endpoint without instruction indexes.
+func tradeTxKey(hash, owner, from, to string, fromAmount, toAmount float64) txKey {
+	return txKey{hash: hash, detail: fmt.Sprintf("%s|%s|%s|%g|%g", owner, from, to, fromAmount, toAmount)}
+}
+
+// ============================================================================
+// Transaction Walkers
+// ============================================================================
+
+// WalkTokenTxsV3 iterates over the whole transaction history of a token through
+// GetTokenTxsV3, getting past the offset window by moving BeforeTime (or
+// AfterTime when sorting ascending). With SortBy "block_number", the block
+// number bounds are moved instead.
+//
+// Example:
+//
+//	// Backfill a month of swaps, newest first
+//	opts := &birdeye.TokenTxsV3Options{AfterTime: time.Now().AddDate(0, -1, 0).Unix()}
+//	for tx, err := range client.WalkTokenTxsV3(ctx, tokenAddress, opts) {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    store(tx)
+//	}
+func (c *HTTPClient) WalkTokenTxsV3(ctx context.Context, address string, opts *TokenTxsV3Options) iter.Seq2[RespTokenTxsItemV3, error] {
+	byBlock := opts != nil && opts.SortBy == "block_number"
+	return walkTxs(ctx, opts, c.paginationWindow(EndpointDefiV3TokenTxs),
+		func(o *TokenTxsV3Options) txCursor {
+			if byBlock {
+				return txCursor{&o.Offset, &o.Limit, &o.BeforeBlockNumber, &o.AfterBlockNumber, o.SortType == "asc"}
+			}
+			return txCursor{&o.Offset, &o.Limit, &o.BeforeTime, &o.AfterTime, o.SortType == "asc"}
+		},
+		func(ctx context.Context, o *TokenTxsV3Options) ([]RespTokenTxsItemV3, bool, error) {
+			resp, err := c.GetTokenTxsV3(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		},
+		func(tx RespTokenTxsItemV3) txKey { return v3TxKey(tx.TxHash, tx.InsIndex, tx.InnerInsIndex) },
+		func(tx RespTokenTxsItemV3) int64 {
+			if byBlock {
+				return tx.BlockNumber
+			}
+			return tx.BlockUnixTime
+		})
+}
+
+// WalkAllTxs iterates over the whole transaction history of all tokens through
+// GetAllTxs, moving the bounds like WalkTokenTxsV3.
+func (c *HTTPClient) WalkAllTxs(ctx context.Context, opts *AllTxsV3Options) iter.Seq2[RespAllTxsItemV3, error] {
+	byBlock := opts != nil && opts.SortBy == "block_number"
+	return walkTxs(ctx, opts, c.paginationWindow(EndpointDefiV3Txs),
+		func(o *AllTxsV3Options) txCursor {
+			if byBlock {
+				return txCursor{&o.Offset, &o.Limit, &o.BeforeBlockNumber, &o.AfterBlockNumber, o.SortType == "asc"}
+			}
+			return txCursor{&o.Offset, &o.Limit, &o.BeforeTime, &o.AfterTime, o.SortType == "asc"}
+		},
+		func(ctx context.Context, o *AllTxsV3Options) ([]RespAllTxsItemV3, bool, error) {
+			resp, err := c.GetAllTxs(ctx, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		},
+		func(tx RespAllTxsItemV3) txKey { return v3TxKey(tx.TxHash, tx.InsIndex, tx.InnerInsIndex) },
+		func(tx RespAllTxsItemV3) int64 {
+			if byBlock {
+				return tx.BlockNumber
+			}
+			return tx.BlockUnixTime
+		})
+}
+
+// WalkTokenTxsByTime iterates over the whole transaction history of a token
+// through GetTokenTxsByTime, moving BeforeTime (or AfterTime when sorting ascending).
+func (c *HTTPClient) WalkTokenTxsByTime(ctx context.Context, address string, opts *TokenTxsByTimeOptions) iter.Seq2[RespTokenTxsItem, error] {
+	return walkTxs(ctx, opts, c.paginationWindow(EndpointDefiTxsTokenSeekByTime),
+		func(o *TokenTxsByTimeOptions) txCursor {
+			return txCursor{&o.Offset, &o.Limit, &o.BeforeTime, &o.AfterTime, o.SortType == "asc"}
+		},
+		func(ctx context.Context, o *TokenTxsByTimeOptions) ([]RespTokenTxsItem, bool, error) {
+			resp, err := c.GetTokenTxsByTime(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		},
+		func(tx RespTokenTxsItem) txKey {
+			return tradeTxKey(tx.TxHash, tx.Owner, tx.From.Address, tx.To.Address, tx.From.UIAmount, tx.To.UIAmount)
+		},
+		func(tx RespTokenTxsItem) int64 { return tx.BlockUnixTime })
+}
+
+// WalkPairTxsByTime iterates over the whole transaction history of a pair
+// through GetPairTxsByTime, moving BeforeTime (or AfterTime when sorting ascending).
+func (c *HTTPClient) WalkPairTxsByTime(ctx context.Context, address string, opts *PairTxsByTimeOptions) iter.Seq2[RespPairTxsItem, error] {
+	return walkTxs(ctx, opts, c.paginationWindow(EndpointDefiTxsPairSeekByTime),
+		func(o *PairTxsByTimeOptions) txCursor {
+			return txCursor{&o.Offset, &o.Limit, &o.BeforeTime, &o.AfterTime, o.SortType == "asc"}
+		},
+		func(ctx context.Context, o *PairTxsByTimeOptions) ([]RespPairTxsItem, bool, error) {
+			resp, err := c.GetPairTxsByTime(ctx, address, o)
+			if err != nil || resp == nil {
+				return nil, false, err
+			}
+			return resp.Items, resp.HasNext, nil
+		},
+		func(tx RespPairTxsItem) txKey {
+			return tradeTxKey(tx.TxHash, tx.Owner, tx.From.Address, tx.To.Address, tx.From.UIAmount, tx.To.UIAmount)
+		},
+		func(tx RespPairTxsItem) int64 { return tx.BlockUnixTime })
+}
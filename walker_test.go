@@ -0,0 +1,177 @@
+package birdeye
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// walkerHistory serves total v3 token transactions, three per second and
+// newest first, rejecting requests beyond the offset window. before_time and
+// after_time are inclusive bounds if inclusive is set.
+func walkerHistory(t *testing.T, total int, inclusive bool) (*HTTPClient, *requestRecorder) {
+	t.Helper()
+	return walkerHistoryWith(t, HTTPClientConfig{}, total, 3, inclusive)
+}
+
+// walkerHistoryWith is walkerHistory with perSecond transactions per second
+// and the client configured by config, whose pagination window the server
+// enforces.
+func walkerHistoryWith(t *testing.T, config HTTPClientConfig, total, perSecond int, inclusive bool) (*HTTPClient, *requestRecorder) {
+	t.Helper()
+	const newest = 1700000000
+	window := int(MaxPaginationWindow)
+	if w, ok := config.PaginationWindows[EndpointDefiV3TokenTxs]; ok {
+		window = int(w)
+	}
+	return newRecordingClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		before, _ := strconv.ParseInt(q.Get("before_time"), 10, 64)
+		after, _ := strconv.ParseInt(q.Get("after_time"), 10, 64)
+		if offset+limit > window {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"success":false,"message":"offset + limit must be <= 10000"}`))
+			return
+		}
+
+		var matching []map[string]any
+		for i := range total {
+			ts := int64(newest - i/perSecond)
+			if inclusive && (before > 0 && ts > before || after > 0 && ts < after) ||
+				!inclusive && (before > 0 && ts >= before || after > 0 && ts <= after) {
+				continue
+			}
+			matching = append(matching, map[string]any{
+				"tx_hash":         "tx-" + strconv.Itoa(i/perSecond),
+				"ins_index":       i % perSecond,
+				"inner_ins_index": 0,
+				"block_unix_time": ts,
+			})
+		}
+		if q.Get("sort_type") == "asc" {
+			for i, j := 0, len(matching)-1; i < j; i, j = i+1, j-1 {
+				matching[i], matching[j] = matching[j], matching[i]
+			}
+		}
+		end := min(float64(offset+limit), float64(len(matching)))
+		page := []map[string]any{}
+		if offset < len(matching) {
+			page = matching[offset:int(end)]
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"data":    map[string]any{"items": page, "has_next": int(end) < len(matching)},
+		})
+	})
+}
+
+// collectWalk walks the v3 token transactions and checks each is seen once.
+func collectWalk(t *testing.T, client *HTTPClient, opts *TokenTxsV3Options) []RespTokenTxsItemV3 {
+	t.Helper()
+	var items []RespTokenTxsItemV3
+	seen := make(map[txKey]bool)
+	for tx, err := range client.WalkTokenTxsV3(context.Background(), testTokenSOL, opts) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		key := v3TxKey(tx.TxHash, tx.InsIndex, tx.InnerInsIndex)
+		if seen[key] {
+			t.Fatalf("Transaction %+v yielded twice", key)
+		}
+		seen[key] = true
+		items = append(items, tx)
+	}
+	return items
+}
+
+func TestWalkTokenTxsV3(t *testing.T) {
+	for _, inclusive := range []bool{false, true} {
+		client, rec := walkerHistory(t, 25000, inclusive)
+		items := collectWalk(t, client, nil)
+		if len(items) != 25000 {
+			t.Fatalf("inclusive=%v: expected 25000 transactions, got %d", inclusive, len(items))
+		}
+		for i := 1; i < len(items); i++ {
+			if items[i].BlockUnixTime > items[i-1].BlockUnixTime {
+				t.Fatalf("inclusive=%v: expected newest first at %d", inclusive, i)
+			}
+		}
+		if rec.count() > 260 {
+			t.Fatalf("inclusive=%v: expected about 250 requests, got %d", inclusive, rec.count())
+		}
+	}
+}
+
+func TestWalkTokenTxsV3Ascending(t *testing.T) {
+	client, _ := walkerHistory(t, 12000, true)
+	items := collectWalk(t, client, &TokenTxsV3Options{SortType: "asc"})
+	if len(items) != 12000 || items[0].BlockUnixTime > items[len(items)-1].BlockUnixTime {
+		t.Fatalf("Expected 12000 transactions oldest first, got %d", len(items))
+	}
+}
+
+func TestWalkBreak(t *testing.T) {
+	client, rec := walkerHistory(t, 25000, false)
+	count := 0
+	for _, err := range client.WalkTokenTxsV3(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count++; count == 150 {
+			break
+		}
+	}
+	if rec.count() != 2 {
+		t.Fatalf("Expected fetching to stop at the break, got %d requests", rec.count())
+	}
+}
+
+func TestWalkCrowdedWindow(t *testing.T) {
+	// 50 transactions per second do not fit in a window of 30
+	client, _ := walkerHistoryWith(t, HTTPClientConfig{
+		PaginationWindows: map[string]int64{EndpointDefiV3TokenTxs: 30},
+	}, 100, 50, false)
+
+	count := 0
+	var errs []error
+	for _, err := range client.WalkTokenTxsV3(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	if count != 60 || len(errs) != 2 {
+		t.Fatalf("Expected 60 transactions and 2 errors, got %d and %v", count, errs)
+	}
+	for _, err := range errs {
+		if !errors.Is(err, ErrPaginationWindow) {
+			t.Fatalf("Expected ErrPaginationWindow, got %v", err)
+		}
+	}
+}
+
+func TestWalkSkipped(t *testing.T) {
+	client, rec := walkerHistoryWith(t, skippingConfig(t, EndpointDefiV3TokenTxs, 2), 25000, 3, false)
+
+	count := 0
+	var errs []error
+	for _, err := range client.WalkTokenTxsV3(context.Background(), testTokenSOL, nil) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+	if count != 200 || len(errs) != 1 || !errors.Is(errs[0], ErrRateLimitExceeded) {
+		t.Fatalf("Expected 200 transactions then ErrRateLimitExceeded, got %d and %v", count, errs)
+	}
+	if rec.count() != 2 {
+		t.Fatalf("Expected the skipped page not to be requested, got %d requests", rec.count())
+	}
+}
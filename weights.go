@@ -0,0 +1,80 @@
+package birdeye
+
+// Rate limit weights of the endpoints.
+//
+// Every call takes tokens from its endpoint's limiter. Most calls take one,
+// but batch calls querying many addresses count heavier. The weight of a
+// call is looked up in DefaultEndpointWeights, overridden through
+// HTTPClientConfig.EndpointWeights, and passed to Limiter.Acquire.
+
+// ============================================================================
+// Endpoint Weights
+// ============================================================================
+
+// EndpointWeight returns the number of rate limit tokens a call consumes,
+// given its query parameters or body.
+type EndpointWeight func(params map[string]any) int
+
+// FixedWeight returns an EndpointWeight charging the same number of tokens for every call.
+func FixedWeight(tokens int) EndpointWeight {
+	return func(map[string]any) int {
+		return tokens
+	}
+}
+
+// AddressWeight returns an EndpointWeight charging one token per perToken
+// addresses queried, rounded up.
+//
+// Example:
+//
+//	// A 100-address call takes 10 tokens
+//	weight := birdeye.AddressWeight(10)
+func AddressWeight(perToken int) EndpointWeight {
+	perToken = max(1, perToken)
+	return func(params map[string]any) int {
+		return (countAddresses(params) + perToken - 1) / perToken
+	}
+}
+
+// DefaultEndpointWeights holds the rate limit weight of the endpoints that do
+// not take a single token per call.
+//
+// Batch endpoints take one token per 10 addresses. Override or extend the
+// weights through HTTPClientConfig.EndpointWeights.
+var DefaultEndpointWeights = map[string]EndpointWeight{
+	EndpointDefiMultiPrice:                   AddressWeight(10),
+	EndpointDefiPriceVolumeMulti:             AddressWeight(10),
+	EndpointDefiV3TokenMetadataMultiple:      AddressWeight(10),
+	EndpointDefiV3TokenMarketDataMultiple:    AddressWeight(10),
+	EndpointDefiV3TokenTradeDataMultiple:     AddressWeight(10),
+	EndpointDefiV3AllTimeTradesMultiple:      AddressWeight(10),
+	EndpointDefiV3PairOverviewMultiple:       AddressWeight(10),
+	EndpointDefiV3PriceStatsMultiple:         AddressWeight(10),
+	EndpointDefiV3TokenExitLiquidityMultiple: AddressWeight(10),
+	EndpointTokenV1HolderBatch:               AddressWeight(10),
+	EndpointV2WalletPnlMultiple:              AddressWeight(10),
+	EndpointV2WalletTokenBalance:             AddressWeight(10),
+}
+
+// weightFor returns the tokens a call takes, at least one.
+func weightFor(weights map[string]EndpointWeight, endpoint string, params map[string]any) int {
+	weight, ok := weights[endpoint]
+	if !ok || weight == nil {
+		return 1
+	}
+	return max(1, weight(params))
+}
+
+// capWeight caps tokens at the smallest limit of the limiter, so that a heavy
+// call can still be served once the limiter is full.
+func capWeight(limiter Limiter, tokens int) int {
+	if tokens <= 1 {
+		return tokens
+	}
+	for _, status := range limiter.GetStatus() {
+		if status.Limit > 0 && status.Limit < tokens {
+			tokens = status.Limit
+		}
+	}
+	return tokens
+}
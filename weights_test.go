@@ -0,0 +1,90 @@
+package birdeye
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEndpointWeights(t *testing.T) {
+	addresses := make([]string, 25)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("address-%d", i)
+	}
+
+	tests := []struct {
+		name     string
+		weights  map[string]EndpointWeight
+		endpoint string
+		params   map[string]any
+		expected int
+	}{
+		{"unlisted endpoint", DefaultEndpointWeights, EndpointDefiPrice, map[string]any{"address": "a"}, 1},
+		{"batch of 25", DefaultEndpointWeights, EndpointDefiMultiPrice, map[string]any{"list_address": addresses}, 3},
+		{"joined batch", DefaultEndpointWeights, EndpointDefiMultiPrice, map[string]any{"list_address": "a,b,c"}, 1},
+		{"fixed", map[string]EndpointWeight{EndpointDefiPrice: FixedWeight(4)}, EndpointDefiPrice, nil, 4},
+		{"at least one", map[string]EndpointWeight{EndpointDefiPrice: FixedWeight(0)}, EndpointDefiPrice, nil, 1},
+		{"per address", map[string]EndpointWeight{EndpointDefiMultiPrice: AddressWeight(1)}, EndpointDefiMultiPrice, map[string]any{"list_address": addresses}, 25},
+	}
+	for _, tt := range tests {
+		if got := weightFor(tt.weights, tt.endpoint, tt.params); got != tt.expected {
+			t.Errorf("%s: expected weight %d, got %d", tt.name, tt.expected, got)
+		}
+	}
+}
+
+func TestHTTPClientEndpointWeights(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{}}`))
+	}))
+	defer server.Close()
+
+	clock := NewManualClock(time.Now())
+	multi, _ := NewRateLimiter(100, time.Minute, RateLimitRaise, WithClock(clock))
+	single, _ := NewRateLimiter(100, time.Minute, RateLimitRaise, WithClock(clock))
+	small, _ := NewRateLimiter(2, time.Minute, RateLimitRaise, WithClock(clock))
+	client := NewHTTPClient(HTTPClientConfig{
+		APIKey:  "test-key",
+		BaseURL: server.URL,
+		Clock:   clock,
+		EndpointLimiters: map[string]Limiter{
+			EndpointDefiMultiPrice:          multi,
+			EndpointDefiPrice:               single,
+			EndpointDefiHistoricalPriceUnix: small,
+		},
+		EndpointWeights: map[string]EndpointWeight{
+			EndpointDefiPrice:               FixedWeight(5),
+			EndpointDefiHistoricalPriceUnix: FixedWeight(5),
+		},
+	})
+
+	addresses := make([]string, 25)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("address-%d", i)
+	}
+	ctx := context.Background()
+	if _, err := client.GetMultiTokenPrice(ctx, addresses, nil); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := multi.GetAvailableTokens(); tokens != 97 {
+		t.Fatalf("Expected a 25-address call to take 3 tokens, %v left", tokens)
+	}
+
+	if _, err := client.GetTokenPrice(ctx, testTokenSOL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := single.GetAvailableTokens(); tokens != 95 {
+		t.Fatalf("Expected the configured weight of 5 tokens, %v left", tokens)
+	}
+
+	// A weight above the limit takes the whole bucket
+	if _, err := client.GetTokenPriceHistoryByTime(ctx, testTokenSOL, 1700000000, nil); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := small.GetAvailableTokens(); tokens != 0 {
+		t.Fatalf("Expected the weight to be capped at the limit, %v left", tokens)
+	}
+}